@@ -232,6 +232,23 @@ func (c *BridgeContract) VoteProposal(
 	)
 }
 
+// VoteProposalAsync sends vote transaction and returns handle resolved once vote is mined or dropped
+func (c *BridgeContract) VoteProposalAsync(
+	proposal *proposal.Proposal,
+	opts transactor.TransactOptions,
+) (*transactor.TxHandle, error) {
+	log.Debug().
+		Str("depositNonce", strconv.FormatUint(proposal.DepositNonce, 10)).
+		Str("resourceID", hexutil.Encode(proposal.ResourceId[:])).
+		Str("handler", proposal.HandlerAddress.String()).
+		Msgf("Vote proposal asynchronously")
+	return c.ExecuteTransactionAsync(
+		"voteProposal",
+		opts,
+		proposal.Source, proposal.DepositNonce, proposal.ResourceId, proposal.Data,
+	)
+}
+
 func (c *BridgeContract) SimulateVoteProposal(proposal *proposal.Proposal) error {
 	log.Debug().
 		Str("depositNonce", strconv.FormatUint(proposal.DepositNonce, 10)).
@@ -328,6 +345,22 @@ func (c *BridgeContract) IsProposalVotedBy(by common.Address, p *proposal.Propos
 	return out, nil
 }
 
+// GetDepositCount returns number of deposits made to destination domain
+func (c *BridgeContract) GetDepositCount(destDomainID uint8) (uint64, error) {
+	return c.GetDepositCountAt(destDomainID, nil)
+}
+
+// GetDepositCountAt returns number of deposits made to destination domain at the block
+func (c *BridgeContract) GetDepositCountAt(destDomainID uint8, blockNumber *big.Int) (uint64, error) {
+	log.Debug().Msgf("Getting deposit count for domain %d", destDomainID)
+	res, err := c.CallContractAt(blockNumber, "_depositCounts", destDomainID)
+	if err != nil {
+		return 0, err
+	}
+	out := *abi.ConvertType(res[0], new(uint64)).(*uint64)
+	return out, nil
+}
+
 func (c *BridgeContract) GetHandlerAddressForResourceID(
 	resourceID types.ResourceID,
 ) (common.Address, error) {
@@ -414,3 +414,13 @@ func (s *ProposalStatusTestSuite) TestBridge_GetHandlerAddressForResourceID_Succ
 	)
 	s.Nil(err)
 }
+
+func (s *ProposalStatusTestSuite) TestBridge_GetDepositCount_Success() {
+	s.mockContractCaller.EXPECT().From().Times(1).Return(common.Address{})
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(common.LeftPadBytes([]byte{5}, 32), nil)
+
+	count, err := s.bridgeContract.GetDepositCount(2)
+
+	s.Nil(err)
+	s.Equal(uint64(5), count)
+}
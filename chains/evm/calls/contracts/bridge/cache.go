@@ -0,0 +1,121 @@
+package bridge
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxHandlerTTL limits how long handler addresses are cached for, as there is no bridge event
+// emitted when resource handler is changed
+const MaxHandlerTTL = time.Minute
+
+// CacheOpts configures how long bridge reads are cached for. HandlerTTL is capped to MaxHandlerTTL.
+type CacheOpts struct {
+	HandlerTTL   time.Duration
+	ThresholdTTL time.Duration
+	RelayerTTL   time.Duration
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// CachedBridgeContract caches bridge reads that change only through admin transactions.
+// Cached entries expire after their TTL or once invalidated when bridge events changing them are seen.
+// Handler addresses are only cached for a short TTL and unregistered resources are not cached.
+type CachedBridgeContract struct {
+	*BridgeContract
+	opts CacheOpts
+
+	lock      sync.Mutex
+	handlers  map[types.ResourceID]cacheEntry
+	relayers  map[common.Address]cacheEntry
+	threshold *cacheEntry
+}
+
+func NewCachedBridgeContract(bridgeContract *BridgeContract, opts CacheOpts) *CachedBridgeContract {
+	if opts.HandlerTTL > MaxHandlerTTL {
+		opts.HandlerTTL = MaxHandlerTTL
+	}
+	return &CachedBridgeContract{
+		BridgeContract: bridgeContract,
+		opts:           opts,
+		handlers:       make(map[types.ResourceID]cacheEntry),
+		relayers:       make(map[common.Address]cacheEntry),
+	}
+}
+
+func (c *CachedBridgeContract) GetHandlerAddressForResourceID(resourceID types.ResourceID) (common.Address, error) {
+	c.lock.Lock()
+	entry, ok := c.handlers[resourceID]
+	c.lock.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.value.(common.Address), nil
+	}
+
+	handler, err := c.BridgeContract.GetHandlerAddressForResourceID(resourceID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if handler == (common.Address{}) {
+		return handler, nil
+	}
+	c.lock.Lock()
+	c.handlers[resourceID] = cacheEntry{value: handler, expires: time.Now().Add(c.opts.HandlerTTL)}
+	c.lock.Unlock()
+	return handler, nil
+}
+
+func (c *CachedBridgeContract) GetThreshold() (uint8, error) {
+	c.lock.Lock()
+	entry := c.threshold
+	c.lock.Unlock()
+	if entry != nil && time.Now().Before(entry.expires) {
+		return entry.value.(uint8), nil
+	}
+
+	threshold, err := c.BridgeContract.GetThreshold()
+	if err != nil {
+		return 0, err
+	}
+	c.lock.Lock()
+	c.threshold = &cacheEntry{value: threshold, expires: time.Now().Add(c.opts.ThresholdTTL)}
+	c.lock.Unlock()
+	return threshold, nil
+}
+
+func (c *CachedBridgeContract) IsRelayer(relayerAddress common.Address) (bool, error) {
+	c.lock.Lock()
+	entry, ok := c.relayers[relayerAddress]
+	c.lock.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.value.(bool), nil
+	}
+
+	isRelayer, err := c.BridgeContract.IsRelayer(relayerAddress)
+	if err != nil {
+		return false, err
+	}
+	c.lock.Lock()
+	c.relayers[relayerAddress] = cacheEntry{value: isRelayer, expires: time.Now().Add(c.opts.RelayerTTL)}
+	c.lock.Unlock()
+	return isRelayer, nil
+}
+
+// InvalidateThreshold drops cached relayer threshold
+func (c *CachedBridgeContract) InvalidateThreshold() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.threshold = nil
+}
+
+// InvalidateRelayers drops cached relayer checks of all addresses
+func (c *CachedBridgeContract) InvalidateRelayers() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.relayers = make(map[common.Address]cacheEntry)
+}
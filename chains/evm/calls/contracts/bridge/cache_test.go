@@ -0,0 +1,131 @@
+package bridge_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	thresholdResponse = common.LeftPadBytes([]byte{2}, 32)
+	handlerResponse   = common.LeftPadBytes([]byte{1, 2, 3, 4, 5}, 32)
+	isRelayerResponse = common.LeftPadBytes([]byte{1}, 32)
+)
+
+type CachedBridgeContractTestSuite struct {
+	suite.Suite
+	mockContractCaller *mock_calls.MockContractCallerDispatcher
+	cachedBridge       *bridge.CachedBridgeContract
+}
+
+func TestRunCachedBridgeContractTestSuite(t *testing.T) {
+	suite.Run(t, new(CachedBridgeContractTestSuite))
+}
+
+func (s *CachedBridgeContractTestSuite) SetupSuite()    {}
+func (s *CachedBridgeContractTestSuite) TearDownSuite() {}
+func (s *CachedBridgeContractTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockContractCaller = mock_calls.NewMockContractCallerDispatcher(gomockController)
+	s.mockContractCaller.EXPECT().From().Return(common.HexToAddress(testInteractorAddress)).AnyTimes()
+	s.cachedBridge = bridge.NewCachedBridgeContract(
+		bridge.NewBridgeContract(s.mockContractCaller, common.HexToAddress(testContractAddress), nil),
+		bridge.CacheOpts{HandlerTTL: time.Hour, ThresholdTTL: time.Hour, RelayerTTL: time.Hour},
+	)
+}
+func (s *CachedBridgeContractTestSuite) TearDownTest() {}
+
+func (s *CachedBridgeContractTestSuite) TestGetThreshold_Cached() {
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(thresholdResponse, nil).Times(1)
+
+	first, err := s.cachedBridge.GetThreshold()
+	s.Nil(err)
+	second, err := s.cachedBridge.GetThreshold()
+	s.Nil(err)
+
+	s.Equal(uint8(2), first)
+	s.Equal(uint8(2), second)
+}
+
+func (s *CachedBridgeContractTestSuite) TestGetThreshold_Invalidated() {
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(thresholdResponse, nil).Times(2)
+
+	_, err := s.cachedBridge.GetThreshold()
+	s.Nil(err)
+	s.cachedBridge.InvalidateThreshold()
+	_, err = s.cachedBridge.GetThreshold()
+
+	s.Nil(err)
+}
+
+func (s *CachedBridgeContractTestSuite) TestGetThreshold_ErrorNotCached() {
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(nil, errors.New("error"))
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(thresholdResponse, nil)
+
+	_, err := s.cachedBridge.GetThreshold()
+	s.NotNil(err)
+	threshold, err := s.cachedBridge.GetThreshold()
+
+	s.Nil(err)
+	s.Equal(uint8(2), threshold)
+}
+
+func (s *CachedBridgeContractTestSuite) TestGetHandlerAddressForResourceID_Expired() {
+	cachedBridge := bridge.NewCachedBridgeContract(
+		bridge.NewBridgeContract(s.mockContractCaller, common.HexToAddress(testContractAddress), nil),
+		bridge.CacheOpts{HandlerTTL: time.Millisecond},
+	)
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(handlerResponse, nil).Times(2)
+
+	_, err := cachedBridge.GetHandlerAddressForResourceID(testResourceId)
+	s.Nil(err)
+	time.Sleep(5 * time.Millisecond)
+	handler, err := cachedBridge.GetHandlerAddressForResourceID(testResourceId)
+
+	s.Nil(err)
+	s.Equal(common.HexToAddress("0x0000000000000000000000000000000102030405"), handler)
+}
+
+func (s *CachedBridgeContractTestSuite) TestGetHandlerAddressForResourceID_CachedPerResource() {
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(handlerResponse, nil).Times(2)
+
+	_, err := s.cachedBridge.GetHandlerAddressForResourceID(testResourceId)
+	s.Nil(err)
+	_, err = s.cachedBridge.GetHandlerAddressForResourceID(testResourceId)
+	s.Nil(err)
+	_, err = s.cachedBridge.GetHandlerAddressForResourceID([32]byte{1})
+
+	s.Nil(err)
+}
+
+func (s *CachedBridgeContractTestSuite) TestIsRelayer_Invalidated() {
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(isRelayerResponse, nil).Times(2)
+
+	_, err := s.cachedBridge.IsRelayer(common.HexToAddress(testRelayerAddress))
+	s.Nil(err)
+	_, err = s.cachedBridge.IsRelayer(common.HexToAddress(testRelayerAddress))
+	s.Nil(err)
+	s.cachedBridge.InvalidateRelayers()
+	isRelayer, err := s.cachedBridge.IsRelayer(common.HexToAddress(testRelayerAddress))
+
+	s.Nil(err)
+	s.True(isRelayer)
+}
+
+func (s *CachedBridgeContractTestSuite) TestGetHandlerAddressForResourceID_UnregisteredNotCached() {
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(common.LeftPadBytes([]byte{}, 32), nil)
+	s.mockContractCaller.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(handlerResponse, nil)
+
+	_, err := s.cachedBridge.GetHandlerAddressForResourceID(testResourceId)
+	s.Nil(err)
+	handler, err := s.cachedBridge.GetHandlerAddressForResourceID(testResourceId)
+
+	s.Nil(err)
+	s.Equal(common.HexToAddress("0x0000000000000000000000000000000102030405"), handler)
+}
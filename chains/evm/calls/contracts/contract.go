@@ -2,12 +2,17 @@ package contracts
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
+
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rs/zerolog/log"
 )
@@ -19,6 +24,7 @@ type Contract struct {
 	ABI             abi.ABI
 	bytecode        []byte
 	client          calls.ContractCallerDispatcher
+	revertDecoder   *calls.RevertDecoder
 	transactor.Transactor
 }
 
@@ -34,6 +40,7 @@ func NewContract(
 		ABI:             abi,
 		bytecode:        bytecode,
 		client:          client,
+		revertDecoder:   calls.NewRevertDecoder(abi),
 		Transactor:      transactor,
 	}
 }
@@ -67,6 +74,10 @@ func (c *Contract) ExecuteTransaction(method string, opts transactor.TransactOpt
 	}
 	h, err := c.Transact(&c.contractAddress, input, opts)
 	if err != nil {
+		var txErr *evmclient.TransactionFailedError
+		if errors.As(err, &txErr) {
+			err = c.revertDecoder.DecodeFailedTransaction(c.client, txErr)
+		}
 		log.Error().
 			Str("contract", c.contractAddress.String()).
 			Err(err).
@@ -80,14 +91,53 @@ func (c *Contract) ExecuteTransaction(method string, opts transactor.TransactOpt
 	return h, err
 }
 
+// ExecuteTransactionAsync sends transaction without waiting for it to be mined. Transactor
+// has to implement AsyncTransactor. Revert reasons of reverted transactions are decoded and logged.
+func (c *Contract) ExecuteTransactionAsync(method string, opts transactor.TransactOptions, args ...interface{}) (*transactor.TxHandle, error) {
+	async, ok := c.Transactor.(transactor.AsyncTransactor)
+	if !ok {
+		return nil, transactor.ErrAsyncNotSupported
+	}
+	input, err := c.PackMethod(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	handle, err := async.TransactAsync(&c.contractAddress, input, opts)
+	if err != nil {
+		log.Error().
+			Str("contract", c.contractAddress.String()).
+			Err(err).
+			Msgf("error on sending %s", method)
+		return nil, err
+	}
+	handle.OnReverted(func(receipt *types.Receipt) {
+		err := c.revertDecoder.DecodeFailedTransaction(c.client, &evmclient.TransactionFailedError{Receipt: receipt})
+		log.Error().
+			Str("contract", c.contractAddress.String()).
+			Err(err).
+			Msgf("error on executing %s", method)
+	})
+	log.Debug().
+		Str("txHash", handle.Hash().String()).
+		Str("contract", c.contractAddress.String()).
+		Msgf("method %s sent", method)
+	return handle, nil
+}
+
 func (c *Contract) CallContract(method string, args ...interface{}) ([]interface{}, error) {
+	return c.CallContractAt(nil, method, args...)
+}
+
+// CallContractAt calls the contract method at the block. Nil block number is the latest block.
+func (c *Contract) CallContractAt(blockNumber *big.Int, method string, args ...interface{}) ([]interface{}, error) {
 	input, err := c.PackMethod(method, args...)
 	if err != nil {
 		return nil, err
 	}
 	msg := ethereum.CallMsg{From: c.client.From(), To: &c.contractAddress, Data: input}
-	out, err := c.client.CallContract(context.TODO(), calls.ToCallArg(msg), nil)
+	out, err := c.client.CallContract(context.TODO(), calls.ToCallArg(msg), blockNumber)
 	if err != nil {
+		err = c.revertDecoder.DecodeError(err)
 		log.Error().
 			Str("contract", c.contractAddress.String()).
 			Err(err).
@@ -96,7 +146,7 @@ func (c *Contract) CallContract(method string, args ...interface{}) ([]interface
 	}
 	if len(out) == 0 {
 		// Make sure we have a contract to operate on, and bail out otherwise.
-		if code, err := c.client.CodeAt(context.Background(), c.contractAddress, nil); err != nil {
+		if code, err := c.client.CodeAt(context.Background(), c.contractAddress, blockNumber); err != nil {
 			return nil, err
 		} else if len(code) == 0 {
 			return nil, fmt.Errorf("no code at provided address %s", c.contractAddress.String())
@@ -17,6 +17,9 @@ const (
 	ThresholdChangedSig EventSig = "RelayerThresholdChanged(uint256)"
 	ProposalEventSig    EventSig = "ProposalEvent(uint8,uint64,uint8,bytes32)"
 	ProposalVoteSig     EventSig = "ProposalVote(uint8,uint64,uint8,bytes32)"
+	RelayerAddedSig     EventSig = "RelayerAdded(address)"
+	RelayerRemovedSig   EventSig = "RelayerRemoved(address)"
+	UnpausedSig         EventSig = "Unpaused(address)"
 )
 
 // Deposit struct holds event data with all necessary parameters and a handler response
@@ -36,4 +39,31 @@ type Deposit struct {
 	// ERC721Handler: responds with deposited token metadata acquired by calling a tokenURI method in the token contract
 	// GenericHandler: responds with the raw bytes returned from the call to the target contract
 	HandlerResponse []byte
+	// Hash of the transaction that emitted deposit event
+	TxHash common.Hash
+	// Number of the block deposit event was emitted in
+	BlockNumber uint64
 }
+
+// ProposalVote struct holds data of the event emitted when relayer votes on a proposal
+type ProposalVote struct {
+	// ID of chain deposit was made on
+	OriginDomainID uint8
+	// Nonce of deposit
+	DepositNonce uint64
+	// Status of proposal after the vote
+	Status uint8
+	// Hash of handler address and proposal data the relayer voted for
+	DataHash [32]byte
+	// Hash of the vote transaction
+	TxHash common.Hash
+	// Number of the block vote event was emitted in
+	BlockNumber uint64
+}
+
+// Message metadata keys under which listener stores location of the deposit
+// so that it can be independently verified before voting
+const (
+	DepositTxHashMetadataKey      = "depositTxHash"
+	DepositBlockNumberMetadataKey = "depositBlockNumber"
+)
@@ -2,8 +2,10 @@ package events
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"strings"
+	"sync"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -14,11 +16,20 @@ import (
 
 type ChainClient interface {
 	FetchEventLogs(ctx context.Context, contractAddress common.Address, event string, startBlock *big.Int, endBlock *big.Int) ([]ethTypes.Log, error)
+	FetchLogs(ctx context.Context, contractAddress common.Address, events []string, startBlock *big.Int, endBlock *big.Int) ([]ethTypes.Log, error)
 }
 
+// bridgeEventSigs are signatures of bridge configuration and pause events fetched with a single log query
+var bridgeEventSigs = []EventSig{ThresholdChangedSig, RelayerAddedSig, RelayerRemovedSig, UnpausedSig}
+
 type Listener struct {
 	client ChainClient
 	abi    abi.ABI
+
+	lock sync.Mutex
+	// bridgeEventsRange and bridgeEvents cache bridge events of the last fetched block range
+	bridgeEventsRange string
+	bridgeEvents      map[EventSig]bool
 }
 
 func NewListener(client ChainClient) *Listener {
@@ -44,6 +55,8 @@ func (l *Listener) FetchDeposits(ctx context.Context, contractAddress common.Add
 		}
 
 		d.SenderAddress = common.BytesToAddress(dl.Topics[1].Bytes())
+		d.TxHash = dl.TxHash
+		d.BlockNumber = dl.BlockNumber
 		log.Debug().Msgf("Found deposit log in block: %d, TxHash: %s, contractAddress: %s, sender: %s", dl.BlockNumber, dl.TxHash, dl.Address, d.SenderAddress)
 
 		deposits = append(deposits, d)
@@ -52,6 +65,110 @@ func (l *Listener) FetchDeposits(ctx context.Context, contractAddress common.Add
 	return deposits, nil
 }
 
+// FetchProposalVotes returns votes cast on proposals of the bridge in the block range
+func (l *Listener) FetchProposalVotes(ctx context.Context, contractAddress common.Address, startBlock *big.Int, endBlock *big.Int) ([]*ProposalVote, error) {
+	logs, err := l.client.FetchEventLogs(ctx, contractAddress, string(ProposalVoteSig), startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+	votes := make([]*ProposalVote, 0)
+
+	for _, vl := range logs {
+		v, err := l.UnpackProposalVote(l.abi, vl.Data)
+		if err != nil {
+			log.Error().Msgf("failed unpacking proposal vote event log: %v", err)
+			continue
+		}
+
+		v.TxHash = vl.TxHash
+		v.BlockNumber = vl.BlockNumber
+		log.Debug().Msgf("Found proposal vote log in block: %d, TxHash: %s, contractAddress: %s", vl.BlockNumber, vl.TxHash, vl.Address)
+
+		votes = append(votes, v)
+	}
+
+	return votes, nil
+}
+
+// FetchBridgeConfigChanges returns signatures of events changing bridge configuration,
+// like relayer threshold or relayer set, emitted by the bridge in the block range
+func (l *Listener) FetchBridgeConfigChanges(ctx context.Context, contractAddress common.Address, startBlock *big.Int, endBlock *big.Int) ([]EventSig, error) {
+	emitted, err := l.fetchBridgeEvents(ctx, contractAddress, startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]EventSig, 0)
+	for _, sig := range []EventSig{ThresholdChangedSig, RelayerAddedSig, RelayerRemovedSig} {
+		if emitted[sig] {
+			changes = append(changes, sig)
+		}
+	}
+	return changes, nil
+}
+
+// FetchUnpaused returns true if the bridge was unpaused in the block range
+func (l *Listener) FetchUnpaused(ctx context.Context, contractAddress common.Address, startBlock *big.Int, endBlock *big.Int) (bool, error) {
+	emitted, err := l.fetchBridgeEvents(ctx, contractAddress, startBlock, endBlock)
+	if err != nil {
+		return false, err
+	}
+	return emitted[UnpausedSig], nil
+}
+
+// fetchBridgeEvents returns signatures of bridge configuration and pause events emitted in the block range,
+// sorted out of logs of a single query by their first topic. Events of the last block range are cached,
+// as the range is queried by both the bridge cache and the watcher.
+func (l *Listener) fetchBridgeEvents(ctx context.Context, contractAddress common.Address, startBlock *big.Int, endBlock *big.Int) (map[EventSig]bool, error) {
+	blockRange := fmt.Sprintf("%s:%s-%s", contractAddress, startBlock, endBlock)
+	l.lock.Lock()
+	if l.bridgeEvents != nil && l.bridgeEventsRange == blockRange {
+		emitted := l.bridgeEvents
+		l.lock.Unlock()
+		return emitted, nil
+	}
+	l.lock.Unlock()
+
+	sigs := make([]string, len(bridgeEventSigs))
+	for i, sig := range bridgeEventSigs {
+		sigs[i] = string(sig)
+	}
+	logs, err := l.client.FetchLogs(ctx, contractAddress, sigs, startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	emitted := make(map[EventSig]bool)
+	for _, el := range logs {
+		if len(el.Topics) == 0 {
+			continue
+		}
+		for _, sig := range bridgeEventSigs {
+			if el.Topics[0] == sig.GetTopic() {
+				log.Debug().Msgf("Found %s log in block range: %s-%s", sig, startBlock, endBlock)
+				emitted[sig] = true
+			}
+		}
+	}
+
+	l.lock.Lock()
+	l.bridgeEventsRange = blockRange
+	l.bridgeEvents = emitted
+	l.lock.Unlock()
+	return emitted, nil
+}
+
+func (l *Listener) UnpackProposalVote(abi abi.ABI, data []byte) (*ProposalVote, error) {
+	var pv ProposalVote
+
+	err := abi.UnpackIntoInterface(&pv, "ProposalVote", data)
+	if err != nil {
+		return &ProposalVote{}, err
+	}
+
+	return &pv, nil
+}
+
 func (l *Listener) UnpackDeposit(abi abi.ABI, data []byte) (*Deposit, error) {
 	var dl Deposit
 
@@ -1,14 +1,20 @@
 package events_test
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
+	"math/big"
 	"strings"
 	"testing"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	mock_events "github.com/ChainSafe/chainbridge-core/chains/evm/calls/events/mock"
 	"github.com/ChainSafe/chainbridge-core/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 )
@@ -50,3 +56,82 @@ func (s *EvmClientTestSuite) TestUnpackDepositEventLogValidData() {
 	s.Equal(dl.ResourceID, expectedRID)
 	s.Equal(dl.HandlerResponse, []byte{})
 }
+
+func (s *EvmClientTestSuite) TestUnpackProposalVoteEventLogValidData() {
+	abi, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	dataHash := [32]byte{1, 2, 3}
+	data, _ := abi.Events["ProposalVote"].Inputs.NonIndexed().Pack(uint8(1), uint64(5), uint8(1), dataHash)
+
+	pv, err := s.listener.UnpackProposalVote(abi, data)
+
+	s.Nil(err)
+	s.Equal(pv.OriginDomainID, uint8(1))
+	s.Equal(pv.DepositNonce, uint64(5))
+	s.Equal(pv.Status, uint8(1))
+	s.Equal(pv.DataHash, dataHash)
+}
+
+type BridgeEventsTestSuite struct {
+	suite.Suite
+	mockClient *mock_events.MockChainClient
+	listener   *events.Listener
+	bridge     common.Address
+	sigs       []string
+}
+
+func TestRunBridgeEventsTestSuite(t *testing.T) {
+	suite.Run(t, new(BridgeEventsTestSuite))
+}
+
+func (s *BridgeEventsTestSuite) SetupSuite()    {}
+func (s *BridgeEventsTestSuite) TearDownSuite() {}
+func (s *BridgeEventsTestSuite) SetupTest() {
+	s.mockClient = mock_events.NewMockChainClient(gomock.NewController(s.T()))
+	s.listener = events.NewListener(s.mockClient)
+	s.bridge = common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	s.sigs = []string{
+		string(events.ThresholdChangedSig),
+		string(events.RelayerAddedSig),
+		string(events.RelayerRemovedSig),
+		string(events.UnpausedSig),
+	}
+}
+func (s *BridgeEventsTestSuite) TearDownTest() {}
+
+func (s *BridgeEventsTestSuite) TestFetchBridgeConfigChanges_EventsSortedByTopic() {
+	s.mockClient.EXPECT().FetchLogs(gomock.Any(), s.bridge, s.sigs, big.NewInt(1), big.NewInt(5)).Return([]ethTypes.Log{
+		{Topics: []common.Hash{events.RelayerRemovedSig.GetTopic()}},
+		{Topics: []common.Hash{events.UnpausedSig.GetTopic()}},
+		{Topics: []common.Hash{events.ThresholdChangedSig.GetTopic()}},
+		{Topics: []common.Hash{events.RelayerRemovedSig.GetTopic()}},
+	}, nil)
+
+	changes, err := s.listener.FetchBridgeConfigChanges(context.Background(), s.bridge, big.NewInt(1), big.NewInt(5))
+
+	s.Nil(err)
+	s.Equal([]events.EventSig{events.ThresholdChangedSig, events.RelayerRemovedSig}, changes)
+}
+
+func (s *BridgeEventsTestSuite) TestFetchUnpaused_RangeQueriedOnce() {
+	s.mockClient.EXPECT().FetchLogs(gomock.Any(), s.bridge, s.sigs, big.NewInt(1), big.NewInt(5)).Return([]ethTypes.Log{
+		{Topics: []common.Hash{events.UnpausedSig.GetTopic()}},
+	}, nil)
+
+	changes, err := s.listener.FetchBridgeConfigChanges(context.Background(), s.bridge, big.NewInt(1), big.NewInt(5))
+	s.Nil(err)
+	s.Empty(changes)
+	unpaused, err := s.listener.FetchUnpaused(context.Background(), s.bridge, big.NewInt(1), big.NewInt(5))
+	s.Nil(err)
+	s.True(unpaused)
+}
+
+func (s *BridgeEventsTestSuite) TestFetchUnpaused_FailedQueryNotCached() {
+	s.mockClient.EXPECT().FetchLogs(gomock.Any(), s.bridge, s.sigs, big.NewInt(1), big.NewInt(5)).Return(nil, errors.New("error"))
+	s.mockClient.EXPECT().FetchLogs(gomock.Any(), s.bridge, s.sigs, big.NewInt(1), big.NewInt(5)).Return([]ethTypes.Log{}, nil)
+
+	_, err := s.listener.FetchUnpaused(context.Background(), s.bridge, big.NewInt(1), big.NewInt(5))
+	s.NotNil(err)
+	unpaused, err := s.listener.FetchUnpaused(context.Background(), s.bridge, big.NewInt(1), big.NewInt(5))
+	s.Nil(err)
+	s.False(unpaused)
+}
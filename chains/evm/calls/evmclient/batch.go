@@ -0,0 +1,120 @@
+package evmclient
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+const DefaultMaxCallBatchSize = 100
+
+type BatchCaller interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+type batchedCall struct {
+	elem rpc.BatchElem
+	done chan struct{}
+}
+
+// CallBatcher coalesces contract calls made within the batch window into a single JSON-RPC
+// batch request. Batch is sent once the window after its first call passes or it reaches max size.
+type CallBatcher struct {
+	client       BatchCaller
+	window       time.Duration
+	maxBatchSize int
+
+	lock    sync.Mutex
+	pending []*batchedCall
+	timer   *time.Timer
+}
+
+func NewCallBatcher(client BatchCaller, window time.Duration, maxBatchSize int) *CallBatcher {
+	if maxBatchSize <= 0 {
+		maxBatchSize = DefaultMaxCallBatchSize
+	}
+	return &CallBatcher{
+		client:       client,
+		window:       window,
+		maxBatchSize: maxBatchSize,
+	}
+}
+
+func (b *CallBatcher) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
+	var hex hexutil.Bytes
+	call := &batchedCall{
+		elem: rpc.BatchElem{
+			Method: "eth_call",
+			Args:   []interface{}{callArgs, toBlockNumArg(blockNumber)},
+			Result: &hex,
+		},
+		done: make(chan struct{}),
+	}
+
+	b.lock.Lock()
+	b.pending = append(b.pending, call)
+	switch {
+	case len(b.pending) >= b.maxBatchSize:
+		batch := b.take()
+		b.lock.Unlock()
+		go b.send(batch)
+	case len(b.pending) == 1:
+		b.timer = time.AfterFunc(b.window, b.flush)
+		b.lock.Unlock()
+	default:
+		b.lock.Unlock()
+	}
+
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if call.elem.Error != nil {
+		return nil, call.elem.Error
+	}
+	return hex, nil
+}
+
+func (b *CallBatcher) flush() {
+	b.lock.Lock()
+	batch := b.take()
+	b.lock.Unlock()
+	b.send(batch)
+}
+
+// take returns pending calls and starts a new batch. Has to be called with lock held.
+func (b *CallBatcher) take() []*batchedCall {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	return batch
+}
+
+func (b *CallBatcher) send(batch []*batchedCall) {
+	if len(batch) == 0 {
+		return
+	}
+
+	elems := make([]rpc.BatchElem, len(batch))
+	for i, call := range batch {
+		elems[i] = call.elem
+	}
+	log.Trace().Msgf("Sending batch of %d contract calls", len(elems))
+	err := b.client.BatchCallContext(context.TODO(), elems)
+	for i, call := range batch {
+		call.elem.Error = elems[i].Error
+		if err != nil {
+			call.elem.Error = err
+		}
+		close(call.done)
+	}
+}
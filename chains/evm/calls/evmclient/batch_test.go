@@ -0,0 +1,103 @@
+package evmclient_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	mock_evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/mock"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CallBatcherTestSuite struct {
+	suite.Suite
+	mockBatchCaller *mock_evmclient.MockBatchCaller
+}
+
+func TestRunCallBatcherTestSuite(t *testing.T) {
+	suite.Run(t, new(CallBatcherTestSuite))
+}
+
+func (s *CallBatcherTestSuite) SetupSuite()    {}
+func (s *CallBatcherTestSuite) TearDownSuite() {}
+func (s *CallBatcherTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockBatchCaller = mock_evmclient.NewMockBatchCaller(gomockController)
+}
+func (s *CallBatcherTestSuite) TearDownTest() {}
+
+// echoCallData responds to each call with its call data
+func echoCallData(ctx context.Context, b []rpc.BatchElem) error {
+	for _, elem := range b {
+		data := elem.Args[0].(map[string]interface{})["data"].([]byte)
+		*elem.Result.(*hexutil.Bytes) = data
+	}
+	return nil
+}
+
+func (s *CallBatcherTestSuite) callConcurrently(batcher *evmclient.CallBatcher, calls int) ([][]byte, []error) {
+	results := make([][]byte, calls)
+	errs := make([]error, calls)
+	wg := sync.WaitGroup{}
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = batcher.CallContract(context.Background(), map[string]interface{}{"data": []byte{byte(i)}}, nil)
+		}(i)
+	}
+	wg.Wait()
+	return results, errs
+}
+
+func (s *CallBatcherTestSuite) TestCallContract_ConcurrentCallsCoalesced() {
+	batcher := evmclient.NewCallBatcher(s.mockBatchCaller, 100*time.Millisecond, 10)
+	s.mockBatchCaller.EXPECT().BatchCallContext(gomock.Any(), gomock.Len(3)).DoAndReturn(echoCallData)
+
+	results, errs := s.callConcurrently(batcher, 3)
+
+	for i := range results {
+		s.Nil(errs[i])
+		s.Equal([]byte{byte(i)}, results[i])
+	}
+}
+
+func (s *CallBatcherTestSuite) TestCallContract_FullBatchSentImmediately() {
+	batcher := evmclient.NewCallBatcher(s.mockBatchCaller, time.Hour, 2)
+	s.mockBatchCaller.EXPECT().BatchCallContext(gomock.Any(), gomock.Len(2)).DoAndReturn(echoCallData)
+
+	results, errs := s.callConcurrently(batcher, 2)
+
+	s.Nil(errs[0])
+	s.Nil(errs[1])
+	s.Equal([]byte{0}, results[0])
+	s.Equal([]byte{1}, results[1])
+}
+
+func (s *CallBatcherTestSuite) TestCallContract_CallErrorReturnedToCaller() {
+	batcher := evmclient.NewCallBatcher(s.mockBatchCaller, time.Millisecond, 10)
+	s.mockBatchCaller.EXPECT().BatchCallContext(gomock.Any(), gomock.Len(1)).DoAndReturn(func(ctx context.Context, b []rpc.BatchElem) error {
+		b[0].Error = errors.New("execution reverted")
+		return nil
+	})
+
+	_, err := batcher.CallContract(context.Background(), map[string]interface{}{}, nil)
+
+	s.Equal(errors.New("execution reverted"), err)
+}
+
+func (s *CallBatcherTestSuite) TestCallContract_BatchFails() {
+	batcher := evmclient.NewCallBatcher(s.mockBatchCaller, time.Hour, 2)
+	s.mockBatchCaller.EXPECT().BatchCallContext(gomock.Any(), gomock.Len(2)).Return(errors.New("error"))
+
+	_, errs := s.callConcurrently(batcher, 2)
+
+	s.NotNil(errs[0])
+	s.NotNil(errs[1])
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -17,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/ethclient/gethclient"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
 )
 
 type EVMClient struct {
@@ -24,8 +26,20 @@ type EVMClient struct {
 	signer     Signer
 	gethClient *gethclient.Client
 	rpClient   *rpc.Client
-	nonce      *big.Int
+	nonces     *NonceManager
 	nonceLock  sync.Mutex
+	// reconciled is true once tracked nonce was reconciled with the node on first use
+	reconciled bool
+	// healing is set while nonce gaps are being filled
+	healing   int32
+	gapFiller NonceGapFiller
+	batcher   *CallBatcher
+	profile   *ChainProfile
+}
+
+// NonceGapFiller sends transaction with the nonce that fills nonce gap of the account
+type NonceGapFiller interface {
+	FillNonceGap(nonce uint64) (common.Hash, error)
 }
 
 type Signer interface {
@@ -50,12 +64,58 @@ func NewEVMClient(url string, signer Signer) (*EVMClient, error) {
 	if err != nil {
 		return nil, err
 	}
+	return NewEVMClientFromRPC(rpcClient, signer), nil
+}
+
+// NewEVMClientFromRPC creates a client for EVMChain with provided signer that sends requests through the RPC client
+func NewEVMClientFromRPC(rpcClient *rpc.Client, signer Signer) *EVMClient {
 	c := &EVMClient{}
 	c.Client = ethclient.NewClient(rpcClient)
 	c.gethClient = gethclient.New(rpcClient)
 	c.rpClient = rpcClient
 	c.signer = signer
-	return c, nil
+	if signer != nil {
+		c.nonces = NewNonceManager(c.Client, signer.CommonAddress())
+	}
+	return c
+}
+
+// WithSigner creates a client for another signer that shares connection with the client.
+// Nonce of the new signer is tracked separately.
+func (c *EVMClient) WithSigner(signer Signer) *EVMClient {
+	return &EVMClient{
+		Client:     c.Client,
+		gethClient: c.gethClient,
+		rpClient:   c.rpClient,
+		signer:     signer,
+		nonces:     NewNonceManager(c.Client, signer.CommonAddress()),
+		batcher:    c.batcher,
+		profile:    c.profile,
+	}
+}
+
+// EnableCallBatching makes client coalesce contract calls made within the window into JSON-RPC batch requests
+func (c *EVMClient) EnableCallBatching(window time.Duration, maxBatchSize int) {
+	c.batcher = NewCallBatcher(c, window, maxBatchSize)
+}
+
+// SetNonceStore enables persisting of the sender next nonce into the store
+func (c *EVMClient) SetNonceStore(store NonceStorer) error {
+	id, err := c.chainID(context.TODO())
+	if err != nil {
+		return err
+	}
+	if id == nil {
+		return fmt.Errorf("nonces can't be stored without chain ID")
+	}
+	c.nonces.SetStore(store, id)
+	return nil
+}
+
+// SetNonceGapFiller sets filler used to fill nonce gaps instead of sending untracked zero value transfers.
+// Transactor tracking sent transactions by nonce should fill the gaps, as they use its nonces.
+func (c *EVMClient) SetNonceGapFiller(filler NonceGapFiller) {
+	c.gapFiller = filler
 }
 
 func (c *EVMClient) SubscribePendingTransactions(ctx context.Context, ch chan<- common.Hash) (*rpc.ClientSubscription, error) {
@@ -75,6 +135,19 @@ func (c *EVMClient) LatestBlock() (*big.Int, error) {
 	return head.Number, nil
 }
 
+// FinalizedBlock returns number of the latest finalized block
+func (c *EVMClient) FinalizedBlock() (*big.Int, error) {
+	var head *headerNumber
+	err := c.rpClient.CallContext(context.Background(), &head, "eth_getBlockByNumber", "finalized", false)
+	if err == nil && head == nil {
+		err = ethereum.NotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return head.Number, nil
+}
+
 type headerNumber struct {
 	Number *big.Int `json:"number"           gencodec:"required"`
 }
@@ -94,6 +167,15 @@ func (h *headerNumber) UnmarshalJSON(input []byte) error {
 	return nil
 }
 
+// TransactionFailedError is returned when transaction was mined but its execution failed
+type TransactionFailedError struct {
+	Receipt *types.Receipt
+}
+
+func (e *TransactionFailedError) Error() string {
+	return fmt.Sprintf("transaction failed on chain. Receipt status %v", e.Receipt.Status)
+}
+
 func (c *EVMClient) WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error) {
 	retry := 50
 	for retry > 0 {
@@ -104,21 +186,77 @@ func (c *EVMClient) WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error
 			continue
 		}
 		if receipt.Status != 1 {
-			return receipt, fmt.Errorf("transaction failed on chain. Receipt status %v", receipt.Status)
+			return receipt, &TransactionFailedError{Receipt: receipt}
 		}
 		return receipt, nil
 	}
 	return nil, errors.New("tx did not appear")
 }
 
+// BlockReceipts returns receipts of all transactions included in the block in order of inclusion.
+// Receipts are fetched with eth_getBlockReceipts or, if the endpoint does not support it, with
+// a single batch of receipt requests.
+func (c *EVMClient) BlockReceipts(ctx context.Context, blockNumber *big.Int) ([]*types.Receipt, error) {
+	var receipts []*types.Receipt
+	err := c.rpClient.CallContext(ctx, &receipts, "eth_getBlockReceipts", toBlockNumArg(blockNumber))
+	if err == nil {
+		if receipts == nil {
+			return nil, ethereum.NotFound
+		}
+		return receipts, nil
+	}
+	if !isMethodNotFound(err) {
+		return nil, err
+	}
+
+	block, err := c.BlockByNumber(ctx, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	receipts = make([]*types.Receipt, len(block.Transactions()))
+	if len(receipts) == 0 {
+		return receipts, nil
+	}
+	elems := make([]rpc.BatchElem, len(receipts))
+	for i, tx := range block.Transactions() {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_getTransactionReceipt",
+			Args:   []interface{}{tx.Hash()},
+			Result: &receipts[i],
+		}
+	}
+	err = c.rpClient.BatchCallContext(ctx, elems)
+	if err != nil {
+		return nil, err
+	}
+	for i, elem := range elems {
+		if elem.Error != nil {
+			return nil, elem.Error
+		}
+		if receipts[i] == nil {
+			return nil, fmt.Errorf("receipt of transaction %s: %w", block.Transactions()[i].Hash(), ethereum.NotFound)
+		}
+	}
+	return receipts, nil
+}
+
 func (c *EVMClient) GetTransactionByHash(h common.Hash) (tx *types.Transaction, isPending bool, err error) {
 	return c.Client.TransactionByHash(context.Background(), h)
 }
 
 func (c *EVMClient) FetchEventLogs(ctx context.Context, contractAddress common.Address, event string, startBlock *big.Int, endBlock *big.Int) ([]types.Log, error) {
-	logs, err := c.FilterLogs(ctx, buildQuery(contractAddress, event, startBlock, endBlock))
-	if err != nil {
-		return []types.Log{}, err
+	return c.FetchLogs(ctx, contractAddress, []string{event}, startBlock, endBlock)
+}
+
+// FetchLogs returns logs of any of the events emitted by the contract in the block range with a single query per log range
+func (c *EVMClient) FetchLogs(ctx context.Context, contractAddress common.Address, events []string, startBlock *big.Int, endBlock *big.Int) ([]types.Log, error) {
+	logs := make([]types.Log, 0)
+	for _, r := range c.logRanges(startBlock, endBlock) {
+		rangeLogs, err := c.FilterLogs(ctx, buildQuery(contractAddress, events, r[0], r[1]))
+		if err != nil {
+			return []types.Log{}, err
+		}
+		logs = append(logs, rangeLogs...)
 	}
 
 	validLogs := make([]types.Log, 0)
@@ -132,12 +270,22 @@ func (c *EVMClient) FetchEventLogs(ctx context.Context, contractAddress common.A
 	return validLogs, nil
 }
 
-// SendRawTransaction accepts rlp-encode of signed transaction and sends it via RPC call
+// SendRawTransaction sends signed transaction. Transactions the node already has in its pool,
+// e.g. when the request was resent, are treated as sent.
 func (c *EVMClient) SendRawTransaction(ctx context.Context, tx []byte) error {
-	return c.rpClient.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(tx))
+	err := c.rpClient.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(tx))
+	if IsAlreadyKnownError(err) {
+		log.Debug().Err(err).Msg("Transaction already known by node")
+		return nil
+	}
+	return err
 }
 
 func (c *EVMClient) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
+	if c.batcher != nil {
+		return c.batcher.CallContract(ctx, callArgs, blockNumber)
+	}
+
 	var hex hexutil.Bytes
 	err := c.rpClient.CallContext(ctx, &hex, "eth_call", callArgs, toBlockNumArg(blockNumber))
 	if err != nil {
@@ -154,6 +302,10 @@ func (c *EVMClient) CallContext(ctx context.Context, target interface{}, rpcMeth
 	return nil
 }
 
+func (c *EVMClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	return c.rpClient.BatchCallContext(ctx, b)
+}
+
 func (c *EVMClient) PendingCallContract(ctx context.Context, callArgs map[string]interface{}) ([]byte, error) {
 	var hex hexutil.Bytes
 	err := c.rpClient.CallContext(ctx, &hex, "eth_call", callArgs, "pending")
@@ -167,19 +319,30 @@ func (c *EVMClient) From() common.Address {
 	return c.signer.CommonAddress()
 }
 
-func (c *EVMClient) SignAndSendTransaction(ctx context.Context, tx CommonTransaction) (common.Hash, error) {
-	id, err := c.ChainID(ctx)
+// SignTransaction returns RLP-serialized transaction signed by the client signer
+func (c *EVMClient) SignTransaction(ctx context.Context, tx CommonTransaction) ([]byte, error) {
+	id, err := c.chainID(ctx)
 	if err != nil {
-		// panic(err)
 		// Probably chain does not support chainID eg. CELO
+		log.Warn().Err(err).Msg("Failed fetching chain ID, signing transaction without it")
 		id = nil
 	}
-	rawTx, err := tx.RawWithSignature(c.signer, id)
+	return tx.RawWithSignature(c.signer, id)
+}
+
+func (c *EVMClient) SignAndSendTransaction(ctx context.Context, tx CommonTransaction) (common.Hash, error) {
+	rawTx, err := c.SignTransaction(ctx, tx)
 	if err != nil {
 		return common.Hash{}, err
 	}
 	err = c.SendRawTransaction(ctx, rawTx)
 	if err != nil {
+		if IsNonceError(err) {
+			healErr := c.HealNonce(ctx)
+			if healErr != nil {
+				log.Error().Err(healErr).Msg("Failed healing nonce")
+			}
+		}
 		return common.Hash{}, err
 	}
 	return tx.Hash(), nil
@@ -200,30 +363,176 @@ func (c *EVMClient) UnlockNonce() {
 func (c *EVMClient) UnsafeNonce() (*big.Int, error) {
 	var err error
 	for i := 0; i <= 10; i++ {
-		if c.nonce == nil {
-			nonce, err := c.PendingNonceAt(context.Background(), c.signer.CommonAddress())
+		var nonce *big.Int
+		nonce, err = c.nonces.Nonce()
+		if err != nil {
+			time.Sleep(1 * time.Second)
+			continue
+		}
+		if !c.reconciled {
+			c.reconciled = true
+			// stored nonce can be higher than nonces known to the node, leaving gaps
+			err = c.HealNonce(context.TODO())
 			if err != nil {
-				time.Sleep(1 * time.Second)
-				continue
+				log.Error().Err(err).Msg("Failed healing nonce")
 			}
-			c.nonce = big.NewInt(0).SetUint64(nonce)
-			return c.nonce, nil
+			return c.nonces.Nonce()
 		}
-		return c.nonce, nil
+		return nonce, nil
 	}
 	return nil, err
 }
 
 func (c *EVMClient) UnsafeIncreaseNonce() error {
-	nonce, err := c.UnsafeNonce()
+	return c.nonces.Increase()
+}
+
+// HealNonce reconciles tracked nonce with the node and fills nonce gaps left
+// by dropped transactions with the gap filler, or with zero value transfers to the sender itself
+// if it is not set. Nonce errors of gap filling transactions do not heal nonce again.
+func (c *EVMClient) HealNonce(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&c.healing, 0, 1) {
+		return nil
+	}
+	defer atomic.StoreInt32(&c.healing, 0)
+
+	gaps, err := c.nonces.Reconcile()
 	if err != nil {
 		return err
 	}
-	c.nonce = nonce.Add(nonce, big.NewInt(1))
+	for _, n := range gaps {
+		h, err := c.fillNonceGap(ctx, n)
+		if err != nil {
+			// transaction with the nonce can still be queued in the node
+			log.Warn().Err(err).Uint64("nonce", n).Msg("Failed filling nonce gap")
+			continue
+		}
+		log.Info().Uint64("nonce", n).Msgf("Filled nonce gap with transaction %s", h)
+	}
 	return nil
 }
 
+func (c *EVMClient) fillNonceGap(ctx context.Context, nonce uint64) (common.Hash, error) {
+	if c.gapFiller != nil {
+		return c.gapFiller.FillNonceGap(nonce)
+	}
+	return c.sendNoop(ctx, nonce)
+}
+
+// sendNoop sends zero value transfer to the sender itself with the nonce
+func (c *EVMClient) sendNoop(ctx context.Context, nonce uint64) (common.Hash, error) {
+	gasPrice, err := c.SuggestGasPrice(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	id, err := c.chainID(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	from := c.From()
+	tx := types.NewTx(&types.LegacyTx{
+		Nonce:    nonce,
+		To:       &from,
+		Value:    big.NewInt(0),
+		Gas:      21000,
+		GasPrice: gasPrice,
+	})
+	signer := types.LatestSignerForChainID(id)
+	sig, err := c.signer.Sign(signer.Hash(tx).Bytes())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	signedTx, err := tx.WithSignature(signer, sig)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	err = c.SendTransaction(ctx, signedTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return signedTx.Hash(), nil
+}
+
+// FeeHistory is the fee market history of the range of blocks
+type FeeHistory struct {
+	OldestBlock *big.Int
+	// Reward is the effective priority fee per gas at the requested percentiles, per block
+	Reward [][]*big.Int
+	// BaseFee is the base fee per gas of every block and of the block after the newest one
+	BaseFee      []*big.Int
+	GasUsedRatio []float64
+}
+
+// FeeHistory returns fee history of blockCount blocks up to lastBlock with priority fees
+// at reward percentiles. Latest block is used if lastBlock is nil.
+func (c *EVMClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*FeeHistory, error) {
+	var res struct {
+		OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+		Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+		BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+		GasUsedRatio []float64        `json:"gasUsedRatio"`
+	}
+	err := c.rpClient.CallContext(ctx, &res, "eth_feeHistory", hexutil.Uint64(blockCount), toBlockNumArg(lastBlock), rewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &FeeHistory{
+		OldestBlock:  (*big.Int)(res.OldestBlock),
+		Reward:       make([][]*big.Int, len(res.Reward)),
+		BaseFee:      make([]*big.Int, len(res.BaseFee)),
+		GasUsedRatio: res.GasUsedRatio,
+	}
+	for i, blockRewards := range res.Reward {
+		history.Reward[i] = make([]*big.Int, len(blockRewards))
+		for j, r := range blockRewards {
+			history.Reward[i][j] = (*big.Int)(r)
+		}
+	}
+	for i, b := range res.BaseFee {
+		history.BaseFee[i] = (*big.Int)(b)
+	}
+	return history, nil
+}
+
+// TransactionCost returns fee paid by the mined transaction as gas used multiplied by effective
+// gas price, including L1 data fee reported in receipts of Optimism rollups
+func (c *EVMClient) TransactionCost(ctx context.Context, txHash common.Hash) (*big.Int, error) {
+	var res struct {
+		GasUsed           *hexutil.Big `json:"gasUsed"`
+		EffectiveGasPrice *hexutil.Big `json:"effectiveGasPrice"`
+		L1Fee             *hexutil.Big `json:"l1Fee"`
+	}
+	err := c.rpClient.CallContext(ctx, &res, "eth_getTransactionReceipt", txHash)
+	if err != nil {
+		return nil, err
+	}
+	if res.GasUsed == nil {
+		return nil, ethereum.NotFound
+	}
+
+	gasPrice := (*big.Int)(res.EffectiveGasPrice)
+	// effective gas price is missing in receipts of nodes without eip1559 support
+	if gasPrice == nil {
+		tx, _, err := c.TransactionByHash(ctx, txHash)
+		if err != nil {
+			return nil, err
+		}
+		gasPrice = tx.GasPrice()
+	}
+
+	cost := new(big.Int).Mul((*big.Int)(res.GasUsed), gasPrice)
+	if res.L1Fee != nil {
+		cost.Add(cost, (*big.Int)(res.L1Fee))
+	}
+	return cost, nil
+}
+
+// BaseFee returns base fee of the latest block. It is nil on chains without eip1559 support.
 func (c *EVMClient) BaseFee() (*big.Int, error) {
+	if c.profile != nil && !c.profile.EIP1559 {
+		return nil, nil
+	}
 	head, err := c.HeaderByNumber(context.TODO(), nil)
 	if err != nil {
 		return nil, err
@@ -239,14 +548,16 @@ func toBlockNumArg(number *big.Int) string {
 }
 
 // buildQuery constructs a query for the bridgeContract by hashing sig to get the event topic
-func buildQuery(contract common.Address, sig string, startBlock *big.Int, endBlock *big.Int) ethereum.FilterQuery {
+func buildQuery(contract common.Address, sigs []string, startBlock *big.Int, endBlock *big.Int) ethereum.FilterQuery {
+	topics := make([]common.Hash, len(sigs))
+	for i, sig := range sigs {
+		topics[i] = crypto.Keccak256Hash([]byte(sig))
+	}
 	query := ethereum.FilterQuery{
 		FromBlock: startBlock,
 		ToBlock:   endBlock,
 		Addresses: []common.Address{contract},
-		Topics: [][]common.Hash{
-			{crypto.Keccak256Hash([]byte(sig))},
-		},
+		Topics:    [][]common.Hash{topics},
 	}
 	return query
 }
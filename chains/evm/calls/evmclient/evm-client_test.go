@@ -0,0 +1,116 @@
+package evmclient_test
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	mock_evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/simulated"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/local"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BlockReceiptsTestSuite struct {
+	suite.Suite
+	chain  *simulated.Chain
+	server *httptest.Server
+	client *evmclient.EVMClient
+}
+
+func TestRunBlockReceiptsTestSuite(t *testing.T) {
+	suite.Run(t, new(BlockReceiptsTestSuite))
+}
+
+func (s *BlockReceiptsTestSuite) SetupSuite()    {}
+func (s *BlockReceiptsTestSuite) TearDownSuite() {}
+func (s *BlockReceiptsTestSuite) SetupTest() {
+	var err error
+	s.chain, err = simulated.NewChain(core.GenesisAlloc{
+		local.EveKp.CommonAddress(): {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)},
+	})
+	s.Nil(err)
+	s.server = httptest.NewServer(s.chain.Server())
+	s.client, err = evmclient.NewEVMClient(s.server.URL, local.EveKp)
+	s.Nil(err)
+}
+func (s *BlockReceiptsTestSuite) TearDownTest() {
+	s.server.Close()
+	_ = s.chain.Close()
+}
+
+func (s *BlockReceiptsTestSuite) TestBlockReceipts_BatchedWithoutBlockReceiptsMethod() {
+	to := local.BobKp.CommonAddress()
+	hashes := make([]string, 0)
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		tx, err := evmtransaction.NewTransaction(nonce, &to, big.NewInt(1), 21000, []*big.Int{big.NewInt(10000000000)}, nil)
+		s.Nil(err)
+		signed, err := tx.RawWithSignature(local.EveKp, big.NewInt(1337))
+		s.Nil(err)
+		decoded := new(types.Transaction)
+		s.Nil(decoded.UnmarshalBinary(signed))
+		s.Nil(s.chain.Backend().SendTransaction(context.Background(), decoded))
+		hashes = append(hashes, decoded.Hash().Hex())
+	}
+	s.chain.Mine(1)
+
+	receipts, err := s.client.BlockReceipts(context.Background(), big.NewInt(1))
+
+	s.Nil(err)
+	s.Len(receipts, 3)
+	for i, receipt := range receipts {
+		s.Equal(hashes[i], receipt.TxHash.Hex())
+		s.Equal(types.ReceiptStatusSuccessful, receipt.Status)
+	}
+}
+
+type NonceHealingTestSuite struct {
+	suite.Suite
+	chain           *simulated.Chain
+	server          *httptest.Server
+	client          *evmclient.EVMClient
+	mockNonceStorer *mock_evmclient.MockNonceStorer
+}
+
+func TestRunNonceHealingTestSuite(t *testing.T) {
+	suite.Run(t, new(NonceHealingTestSuite))
+}
+
+func (s *NonceHealingTestSuite) SetupSuite()    {}
+func (s *NonceHealingTestSuite) TearDownSuite() {}
+func (s *NonceHealingTestSuite) SetupTest() {
+	var err error
+	s.chain, err = simulated.NewChain(core.GenesisAlloc{
+		local.EveKp.CommonAddress(): {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)},
+	})
+	s.Nil(err)
+	s.server = httptest.NewServer(s.chain.Server())
+	s.client, err = evmclient.NewEVMClient(s.server.URL, local.EveKp)
+	s.Nil(err)
+	s.mockNonceStorer = mock_evmclient.NewMockNonceStorer(gomock.NewController(s.T()))
+	s.Nil(s.client.SetNonceStore(s.mockNonceStorer))
+}
+func (s *NonceHealingTestSuite) TearDownTest() {
+	s.server.Close()
+	_ = s.chain.Close()
+}
+
+func (s *NonceHealingTestSuite) TestUnsafeNonce_GapsBelowStoredNonceFilledOnFirstUse() {
+	s.mockNonceStorer.EXPECT().GetAccountNonce(big.NewInt(1337), local.EveKp.CommonAddress()).Return(big.NewInt(2), nil)
+
+	s.client.LockNonce()
+	nonce, err := s.client.UnsafeNonce()
+	s.client.UnlockNonce()
+
+	s.Nil(err)
+	s.Equal(big.NewInt(2), nonce)
+	pending, err := s.client.PendingNonceAt(context.Background(), local.EveKp.CommonAddress())
+	s.Nil(err)
+	s.Equal(uint64(2), pending)
+}
@@ -0,0 +1,575 @@
+package evmclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+// Endpoint is an RPC endpoint of the chain. Requests are routed to endpoints with
+// higher weight when endpoints are equally fast.
+type Endpoint struct {
+	URL    string
+	Weight int
+}
+
+type FailoverOpts struct {
+	// HealthCheckInterval is the interval head height and latency of endpoints are checked with
+	HealthCheckInterval time.Duration
+	// MaxHeadLag is the number of blocks endpoint head can be behind the highest head of all endpoints
+	MaxHeadLag uint64
+	// RequestTimeout limits duration of a request sent to an endpoint
+	RequestTimeout time.Duration
+	// BroadcastCount is the number of endpoints signed transactions are sent to
+	BroadcastCount int
+	// RateLimit limits the rate of requests sent to each endpoint and retries throttled requests
+	RateLimit RateLimitOpts
+}
+
+var DefaultFailoverOpts = FailoverOpts{
+	HealthCheckInterval: 15 * time.Second,
+	MaxHeadLag:          5,
+	RequestTimeout:      30 * time.Second,
+	BroadcastCount:      3,
+	RateLimit:           DefaultRateLimitOpts,
+}
+
+type endpoint struct {
+	url    string
+	weight int
+
+	client  *rpc.Client
+	alive   bool
+	head    uint64
+	latency time.Duration
+}
+
+type jsonrpcRequest struct {
+	ID     json.RawMessage   `json:"id,omitempty"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params,omitempty"`
+}
+
+type jsonrpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+type jsonrpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+}
+
+type jsonrpcNotification struct {
+	Version string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  subscriptionResult `json:"params"`
+}
+
+type subscriptionResult struct {
+	ID     string          `json:"subscription"`
+	Result json.RawMessage `json:"result"`
+}
+
+// EndpointRouter serves JSON-RPC requests of a client from multiple endpoints. Endpoints are
+// health-checked by head height and latency, requests are routed to the healthiest endpoint
+// and retried on the next one when the endpoint fails. Signed transactions are broadcast to
+// several endpoints and subscriptions are re-established on another endpoint when they fail.
+type EndpointRouter struct {
+	opts FailoverOpts
+
+	lock      sync.Mutex
+	endpoints []*endpoint
+	subs      map[string]context.CancelFunc
+
+	writeLock sync.Mutex
+	responses io.Writer
+}
+
+func NewEndpointRouter(endpoints []Endpoint, opts FailoverOpts) (*EndpointRouter, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("at least one endpoint required")
+	}
+
+	r := &EndpointRouter{
+		opts: opts,
+		subs: make(map[string]context.CancelFunc),
+	}
+	for _, e := range endpoints {
+		weight := e.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		r.endpoints = append(r.endpoints, &endpoint{url: e.URL, weight: weight})
+	}
+	return r, nil
+}
+
+// NewFailoverEVMClient creates a client for EVMChain with provided signer that routes requests between endpoints
+func NewFailoverEVMClient(ctx context.Context, endpoints []Endpoint, signer Signer, opts FailoverOpts) (*EVMClient, error) {
+	r, err := NewEndpointRouter(endpoints, opts)
+	if err != nil {
+		return nil, err
+	}
+	r.Start(ctx)
+
+	rpcClient, err := r.Dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewEVMClientFromRPC(rpcClient, signer), nil
+}
+
+// Start checks health of endpoints and keeps checking it in the background until the context is cancelled
+func (r *EndpointRouter) Start(ctx context.Context) {
+	r.CheckHealth(ctx)
+	go func() {
+		ticker := time.NewTicker(r.opts.HealthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.CheckHealth(ctx)
+			}
+		}
+	}()
+}
+
+// Dial creates RPC client served by the router
+func (r *EndpointRouter) Dial(ctx context.Context) (*rpc.Client, error) {
+	requestsReader, requestsWriter := io.Pipe()
+	responsesReader, responsesWriter := io.Pipe()
+	r.responses = responsesWriter
+	go r.serve(ctx, requestsReader)
+	return rpc.DialIO(ctx, responsesReader, requestsWriter)
+}
+
+// CheckHealth updates head height and latency of all endpoints
+func (r *EndpointRouter) CheckHealth(ctx context.Context) {
+	wg := sync.WaitGroup{}
+	for _, e := range r.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+
+			var head hexutil.Uint64
+			start := time.Now()
+			err := r.callEndpoint(ctx, e, &head, "eth_blockNumber")
+			latency := time.Since(start)
+			if err != nil {
+				log.Warn().Err(err).Str("endpoint", e.url).Msg("Endpoint health check failed")
+			}
+
+			r.lock.Lock()
+			defer r.lock.Unlock()
+			e.alive = err == nil
+			if err == nil {
+				e.head = uint64(head)
+				e.latency = latency
+			}
+		}(e)
+	}
+	wg.Wait()
+}
+
+// candidates returns endpoints ordered from the healthiest one. Endpoints that are down
+// or lag behind are last as they are used only when all healthy endpoints fail.
+func (r *EndpointRouter) candidates() []*endpoint {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	var maxHead uint64
+	for _, e := range r.endpoints {
+		if e.alive && e.head > maxHead {
+			maxHead = e.head
+		}
+	}
+	healthy := func(e *endpoint) bool {
+		return e.alive && e.head+r.opts.MaxHeadLag >= maxHead
+	}
+	score := func(e *endpoint) float64 {
+		return float64(e.latency) / float64(e.weight)
+	}
+
+	candidates := make([]*endpoint, len(r.endpoints))
+	copy(candidates, r.endpoints)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if healthy(a) != healthy(b) {
+			return healthy(a)
+		}
+		if score(a) != score(b) {
+			return score(a) < score(b)
+		}
+		return a.weight > b.weight
+	})
+	return candidates
+}
+
+func (r *EndpointRouter) markFailed(e *endpoint, err error) {
+	log.Warn().Err(err).Str("endpoint", e.url).Msg("Endpoint request failed, failing over")
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	e.alive = false
+}
+
+func (r *EndpointRouter) conn(ctx context.Context, e *endpoint) (*rpc.Client, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if e.client != nil {
+		return e.client, nil
+	}
+
+	client, err := DialRPC(ctx, e.url, r.opts.RateLimit)
+	if err != nil {
+		return nil, err
+	}
+	e.client = client
+	return client, nil
+}
+
+func (r *EndpointRouter) callEndpoint(ctx context.Context, e *endpoint, result interface{}, method string, args ...interface{}) error {
+	client, err := r.conn(ctx, e)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, r.opts.RequestTimeout)
+	defer cancel()
+	return client.CallContext(ctx, result, method, args...)
+}
+
+func (r *EndpointRouter) serve(ctx context.Context, requests io.Reader) {
+	decoder := json.NewDecoder(requests)
+	for {
+		var msg json.RawMessage
+		err := decoder.Decode(&msg)
+		if err != nil {
+			return
+		}
+		go r.handle(ctx, msg)
+	}
+}
+
+func (r *EndpointRouter) handle(ctx context.Context, msg json.RawMessage) {
+	if len(msg) > 0 && msg[0] == '[' {
+		var batch []jsonrpcRequest
+		err := json.Unmarshal(msg, &batch)
+		if err != nil {
+			log.Error().Err(err).Msg("Invalid JSON-RPC batch")
+			return
+		}
+
+		if isPlainBatch(batch) {
+			r.write(r.forwardBatch(ctx, batch))
+			return
+		}
+
+		responses := make([]*jsonrpcResponse, len(batch))
+		subscribed := make([]func(), 0)
+		for i, req := range batch {
+			var start func()
+			responses[i], start = r.call(ctx, req)
+			if start != nil {
+				subscribed = append(subscribed, start)
+			}
+		}
+		r.write(responses)
+		for _, start := range subscribed {
+			start()
+		}
+		return
+	}
+
+	var req jsonrpcRequest
+	err := json.Unmarshal(msg, &req)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid JSON-RPC request")
+		return
+	}
+	res, start := r.call(ctx, req)
+	r.write(res)
+	// notifications are forwarded after client received subscription id
+	if start != nil {
+		start()
+	}
+}
+
+// call serves the request. Returned function starts forwarding notifications of a new subscription.
+func (r *EndpointRouter) call(ctx context.Context, req jsonrpcRequest) (*jsonrpcResponse, func()) {
+	var result interface{}
+	var err error
+	var start func()
+	switch req.Method {
+	case "eth_subscribe":
+		result, start, err = r.subscribe(ctx, req.Params)
+	case "eth_unsubscribe":
+		result, err = r.unsubscribe(req.Params)
+	case "eth_sendRawTransaction":
+		result, err = r.broadcast(ctx, req.Params)
+	default:
+		result, err = r.forward(ctx, req.Method, req.Params)
+	}
+
+	res := &jsonrpcResponse{Version: "2.0", ID: req.ID}
+	if err != nil {
+		res.Error = toJSONRPCError(err)
+		return res, nil
+	}
+	res.Result, err = json.Marshal(result)
+	if err != nil {
+		res.Error = toJSONRPCError(err)
+		return res, nil
+	}
+	return res, start
+}
+
+// forward sends request to the healthiest endpoint, failing over to the next one on errors
+// other than JSON-RPC errors returned by the endpoint
+func (r *EndpointRouter) forward(ctx context.Context, method string, params []json.RawMessage) (json.RawMessage, error) {
+	var lastErr error
+	for _, e := range r.candidates() {
+		var result json.RawMessage
+		err := r.callEndpoint(ctx, e, &result, method, toArgs(params)...)
+		if err == nil || isRPCError(err) {
+			return result, err
+		}
+		r.markFailed(e, err)
+		lastErr = err
+	}
+	return nil, fmt.Errorf("request %s failed on all endpoints: %w", method, lastErr)
+}
+
+// forwardBatch sends batch to the healthiest endpoint as a single request, failing over
+// to the next one if the batch request fails
+func (r *EndpointRouter) forwardBatch(ctx context.Context, batch []jsonrpcRequest) []*jsonrpcResponse {
+	responses := make([]*jsonrpcResponse, len(batch))
+	var lastErr error
+	for _, e := range r.candidates() {
+		client, err := r.conn(ctx, e)
+		if err != nil {
+			r.markFailed(e, err)
+			lastErr = err
+			continue
+		}
+
+		results := make([]json.RawMessage, len(batch))
+		elems := make([]rpc.BatchElem, len(batch))
+		for i, req := range batch {
+			elems[i] = rpc.BatchElem{Method: req.Method, Args: toArgs(req.Params), Result: &results[i]}
+		}
+		batchCtx, cancel := context.WithTimeout(ctx, r.opts.RequestTimeout)
+		err = client.BatchCallContext(batchCtx, elems)
+		cancel()
+		if err != nil {
+			r.markFailed(e, err)
+			lastErr = err
+			continue
+		}
+
+		for i, req := range batch {
+			responses[i] = &jsonrpcResponse{Version: "2.0", ID: req.ID, Result: results[i]}
+			if elems[i].Error != nil {
+				responses[i].Result = nil
+				responses[i].Error = toJSONRPCError(elems[i].Error)
+			}
+		}
+		return responses
+	}
+
+	err := fmt.Errorf("batch request failed on all endpoints: %w", lastErr)
+	for i, req := range batch {
+		responses[i] = &jsonrpcResponse{Version: "2.0", ID: req.ID, Error: toJSONRPCError(err)}
+	}
+	return responses
+}
+
+// broadcast sends signed transaction to BroadcastCount healthiest endpoints and returns
+// the first successful result
+func (r *EndpointRouter) broadcast(ctx context.Context, params []json.RawMessage) (json.RawMessage, error) {
+	candidates := r.candidates()
+	if r.opts.BroadcastCount > 0 && len(candidates) > r.opts.BroadcastCount {
+		candidates = candidates[:r.opts.BroadcastCount]
+	}
+
+	results := make([]json.RawMessage, len(candidates))
+	errs := make([]error, len(candidates))
+	wg := sync.WaitGroup{}
+	for i, e := range candidates {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			errs[i] = r.callEndpoint(ctx, e, &results[i], "eth_sendRawTransaction", toArgs(params)...)
+			if errs[i] != nil && !isRPCError(errs[i]) {
+				r.markFailed(e, errs[i])
+			}
+		}(i, e)
+	}
+	wg.Wait()
+
+	var rpcErr, lastErr error
+	for i := range candidates {
+		switch {
+		case errs[i] == nil:
+			return results[i], nil
+		case isRPCError(errs[i]) && rpcErr == nil:
+			rpcErr = errs[i]
+		default:
+			lastErr = errs[i]
+		}
+	}
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	return nil, fmt.Errorf("transaction broadcast failed on all endpoints: %w", lastErr)
+}
+
+func (r *EndpointRouter) subscribe(ctx context.Context, params []json.RawMessage) (string, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+	sub, ch, err := r.subscribeEndpoint(ctx, params)
+	if err != nil {
+		cancel()
+		return "", nil, err
+	}
+
+	id := string(rpc.NewID())
+	r.lock.Lock()
+	r.subs[id] = cancel
+	r.lock.Unlock()
+	return id, func() { go r.forwardNotifications(ctx, id, params, sub, ch) }, nil
+}
+
+func (r *EndpointRouter) subscribeEndpoint(ctx context.Context, params []json.RawMessage) (*rpc.ClientSubscription, chan json.RawMessage, error) {
+	var lastErr error
+	for _, e := range r.candidates() {
+		client, err := r.conn(ctx, e)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		ch := make(chan json.RawMessage)
+		sub, err := client.EthSubscribe(ctx, ch, toArgs(params)...)
+		if err == nil {
+			return sub, ch, nil
+		}
+		if isRPCError(err) {
+			return nil, nil, err
+		}
+		lastErr = err
+	}
+	return nil, nil, fmt.Errorf("subscription failed on all endpoints: %w", lastErr)
+}
+
+// forwardNotifications forwards subscription notifications to the client and
+// re-subscribes on the healthiest endpoint when the subscription fails
+func (r *EndpointRouter) forwardNotifications(ctx context.Context, id string, params []json.RawMessage, sub *rpc.ClientSubscription, ch chan json.RawMessage) {
+	for {
+		select {
+		case <-ctx.Done():
+			sub.Unsubscribe()
+			return
+		case n := <-ch:
+			r.write(&jsonrpcNotification{
+				Version: "2.0",
+				Method:  "eth_subscription",
+				Params:  subscriptionResult{ID: id, Result: n},
+			})
+		case err := <-sub.Err():
+			log.Warn().Err(err).Msg("Subscription failed, re-subscribing")
+			for {
+				sub, ch, err = r.subscribeEndpoint(ctx, params)
+				if err == nil {
+					break
+				}
+
+				log.Warn().Err(err).Msg("Failed re-subscribing")
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(r.opts.HealthCheckInterval):
+				}
+			}
+		}
+	}
+}
+
+func (r *EndpointRouter) unsubscribe(params []json.RawMessage) (bool, error) {
+	if len(params) == 0 {
+		return false, errors.New("subscription id required")
+	}
+	var id string
+	err := json.Unmarshal(params[0], &id)
+	if err != nil {
+		return false, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	cancel, ok := r.subs[id]
+	if ok {
+		cancel()
+		delete(r.subs, id)
+	}
+	return ok, nil
+}
+
+func (r *EndpointRouter) write(msg interface{}) {
+	r.writeLock.Lock()
+	defer r.writeLock.Unlock()
+	err := json.NewEncoder(r.responses).Encode(msg)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed writing JSON-RPC response")
+	}
+}
+
+// isPlainBatch returns true if batch can be forwarded to a single endpoint as it is
+func isPlainBatch(batch []jsonrpcRequest) bool {
+	for _, req := range batch {
+		switch req.Method {
+		case "eth_subscribe", "eth_unsubscribe", "eth_sendRawTransaction":
+			return false
+		}
+	}
+	return true
+}
+
+func toArgs(params []json.RawMessage) []interface{} {
+	args := make([]interface{}, len(params))
+	for i, p := range params {
+		args[i] = p
+	}
+	return args
+}
+
+// isRPCError returns true if error was returned by the endpoint in JSON-RPC response
+func isRPCError(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr)
+}
+
+func toJSONRPCError(err error) *jsonrpcError {
+	jsonErr := &jsonrpcError{Code: -32000, Message: err.Error()}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		jsonErr.Code = rpcErr.ErrorCode()
+	}
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		jsonErr.Data = dataErr.ErrorData()
+	}
+	return jsonErr
+}
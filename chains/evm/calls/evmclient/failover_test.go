@@ -0,0 +1,152 @@
+package evmclient_test
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/suite"
+)
+
+// testEthService serves chain id as an identifier of the endpoint
+type testEthService struct {
+	id   int64
+	head uint64
+	sent int32
+}
+
+func (s *testEthService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(s.id))
+}
+
+func (s *testEthService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.head)
+}
+
+func (s *testEthService) SendRawTransaction(tx hexutil.Bytes) common.Hash {
+	atomic.AddInt32(&s.sent, 1)
+	return common.Hash{1}
+}
+
+type FailoverTestSuite struct {
+	suite.Suite
+	ctx     context.Context
+	cancel  context.CancelFunc
+	opts    evmclient.FailoverOpts
+	servers []*httptest.Server
+}
+
+func TestRunFailoverTestSuite(t *testing.T) {
+	suite.Run(t, new(FailoverTestSuite))
+}
+
+func (s *FailoverTestSuite) SetupSuite()    {}
+func (s *FailoverTestSuite) TearDownSuite() {}
+func (s *FailoverTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.opts = evmclient.DefaultFailoverOpts
+	s.opts.HealthCheckInterval = time.Hour
+	s.opts.RequestTimeout = time.Second
+	s.opts.RateLimit.MaxRetries = 0
+	s.servers = nil
+}
+func (s *FailoverTestSuite) TearDownTest() {
+	s.cancel()
+	for _, server := range s.servers {
+		server.Close()
+	}
+}
+
+func (s *FailoverTestSuite) endpoint(service *testEthService, weight int) (evmclient.Endpoint, *httptest.Server) {
+	server := rpc.NewServer()
+	_ = server.RegisterName("eth", service)
+	httpServer := httptest.NewServer(server)
+	s.servers = append(s.servers, httpServer)
+	return evmclient.Endpoint{URL: httpServer.URL, Weight: weight}, httpServer
+}
+
+func (s *FailoverTestSuite) TestNewEndpointRouter_NoEndpoints() {
+	_, err := evmclient.NewEndpointRouter([]evmclient.Endpoint{}, s.opts)
+
+	s.NotNil(err)
+}
+
+func (s *FailoverTestSuite) TestLaggingEndpointNotUsed() {
+	lagging, _ := s.endpoint(&testEthService{id: 1, head: 10}, 1000)
+	synced, _ := s.endpoint(&testEthService{id: 2, head: 100}, 1)
+	client, err := evmclient.NewFailoverEVMClient(s.ctx, []evmclient.Endpoint{lagging, synced}, nil, s.opts)
+	s.Nil(err)
+
+	id, err := client.ChainID(s.ctx)
+
+	s.Nil(err)
+	s.Equal(big.NewInt(2), id)
+}
+
+func (s *FailoverTestSuite) TestFailoverOnEndpointOutage() {
+	preferred, preferredServer := s.endpoint(&testEthService{id: 1, head: 100}, 1000000)
+	backup, _ := s.endpoint(&testEthService{id: 2, head: 100}, 1)
+	client, err := evmclient.NewFailoverEVMClient(s.ctx, []evmclient.Endpoint{backup, preferred}, nil, s.opts)
+	s.Nil(err)
+
+	id, err := client.ChainID(s.ctx)
+	s.Nil(err)
+	s.Equal(big.NewInt(1), id)
+
+	preferredServer.Close()
+	id, err = client.ChainID(s.ctx)
+	s.Nil(err)
+	s.Equal(big.NewInt(2), id)
+}
+
+func (s *FailoverTestSuite) TestAllEndpointsDown() {
+	endpoint, server := s.endpoint(&testEthService{id: 1, head: 100}, 1)
+	client, err := evmclient.NewFailoverEVMClient(s.ctx, []evmclient.Endpoint{endpoint}, nil, s.opts)
+	s.Nil(err)
+
+	server.Close()
+	_, err = client.ChainID(s.ctx)
+
+	s.NotNil(err)
+}
+
+func (s *FailoverTestSuite) TestBatchFailover() {
+	preferred, preferredServer := s.endpoint(&testEthService{id: 1, head: 100}, 1000000)
+	backup, _ := s.endpoint(&testEthService{id: 2, head: 100}, 1)
+	client, err := evmclient.NewFailoverEVMClient(s.ctx, []evmclient.Endpoint{backup, preferred}, nil, s.opts)
+	s.Nil(err)
+
+	preferredServer.Close()
+	var id hexutil.Big
+	var head hexutil.Uint64
+	err = client.BatchCallContext(s.ctx, []rpc.BatchElem{
+		{Method: "eth_chainId", Result: &id},
+		{Method: "eth_blockNumber", Result: &head},
+	})
+
+	s.Nil(err)
+	s.Equal(big.NewInt(2), id.ToInt())
+	s.Equal(hexutil.Uint64(100), head)
+}
+
+func (s *FailoverTestSuite) TestTransactionBroadcast() {
+	serviceA := &testEthService{id: 1, head: 100}
+	serviceB := &testEthService{id: 1, head: 100}
+	endpointA, _ := s.endpoint(serviceA, 1)
+	endpointB, _ := s.endpoint(serviceB, 1)
+	client, err := evmclient.NewFailoverEVMClient(s.ctx, []evmclient.Endpoint{endpointA, endpointB}, nil, s.opts)
+	s.Nil(err)
+
+	err = client.SendRawTransaction(s.ctx, []byte{1})
+
+	s.Nil(err)
+	s.Equal(int32(1), atomic.LoadInt32(&serviceA.sent))
+	s.Equal(int32(1), atomic.LoadInt32(&serviceB.sent))
+}
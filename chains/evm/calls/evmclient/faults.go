@@ -0,0 +1,302 @@
+package evmclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrInjectedTimeout = errors.New("injected request timeout")
+
+type Fault string
+
+const (
+	// FaultTimeout makes request hang for FaultOpts.Timeout and fail
+	FaultTimeout Fault = "timeout"
+	// FaultServerError fails request with 503 status
+	FaultServerError Fault = "server-error"
+	// FaultDroppedTransaction accepts transaction without sending it to the endpoint
+	FaultDroppedTransaction Fault = "dropped-transaction"
+	// FaultNonce rejects transaction with nonce too low error
+	FaultNonce Fault = "nonce"
+	// FaultStaleHead returns head FaultOpts.StaleBlocks behind the latest block
+	FaultStaleHead Fault = "stale-head"
+	// FaultReorgedLogs returns event logs as if they were read from blocks that were
+	// reorged out, with block hashes unknown to the chain
+	FaultReorgedLogs Fault = "reorged-logs"
+)
+
+// faultMethods are methods faults can be injected into. Faults not listed apply to all methods.
+var faultMethods = map[Fault][]string{
+	FaultTimeout:            nil,
+	FaultServerError:        nil,
+	FaultDroppedTransaction: {"eth_sendRawTransaction"},
+	FaultNonce:              {"eth_sendRawTransaction"},
+	FaultStaleHead:          {"eth_blockNumber", "eth_getBlockByNumber"},
+	FaultReorgedLogs:        {"eth_getLogs"},
+}
+
+// FaultRule injects the fault into matching requests
+type FaultRule struct {
+	Fault Fault
+	// Probability is the chance of injecting the fault into a matching request
+	Probability float64
+	// Every injects the fault into every n-th matching request. If 0 - not scheduled
+	Every int
+	// Methods limits the fault to requests of the methods. If empty - all methods the fault applies to
+	Methods []string
+}
+
+type FaultOpts struct {
+	Rules []FaultRule
+	// Timeout is the time timed out requests hang for before failing
+	Timeout time.Duration
+	// StaleBlocks is the number of blocks stale heads are behind the latest block
+	StaleBlocks uint64
+	// Seed seeds random fault injection so that runs can be reproduced
+	Seed int64
+}
+
+var DefaultFaultOpts = FaultOpts{
+	Timeout:     30 * time.Second,
+	StaleBlocks: 5,
+}
+
+// FaultInjectingTransport is a HTTP transport of the RPC client that injects faults into
+// JSON-RPC requests, so that recovery of the relayer from endpoint failures can be tested.
+// Batch requests are only failed with timeouts and server errors.
+type FaultInjectingTransport struct {
+	next   http.RoundTripper
+	opts   FaultOpts
+	lock   sync.Mutex
+	rand   *rand.Rand
+	counts []int
+}
+
+func NewFaultInjectingTransport(next http.RoundTripper, opts FaultOpts) (*FaultInjectingTransport, error) {
+	for _, rule := range opts.Rules {
+		if _, ok := faultMethods[rule.Fault]; !ok {
+			return nil, fmt.Errorf("unsupported fault %s", rule.Fault)
+		}
+	}
+	return &FaultInjectingTransport{
+		next:   next,
+		opts:   opts,
+		rand:   rand.New(rand.NewSource(opts.Seed)),
+		counts: make([]int, len(opts.Rules)),
+	}, nil
+}
+
+func (t *FaultInjectingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var msg jsonrpcRequest
+	batch := json.Unmarshal(body, &msg) != nil
+	fault, ok := t.pick(msg.Method, batch)
+	if !ok {
+		return t.forward(req, body)
+	}
+	log.Debug().Str("method", msg.Method).Msgf("Injecting %s fault", fault)
+
+	switch fault {
+	case FaultTimeout:
+		timer := time.NewTimer(t.opts.Timeout)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return nil, ErrInjectedTimeout
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	case FaultServerError:
+		return &http.Response{
+			Status:     http.StatusText(http.StatusServiceUnavailable),
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("injected server error"))),
+			Request:    req,
+		}, nil
+	case FaultDroppedTransaction:
+		var input hexutil.Bytes
+		tx := new(types.Transaction)
+		if len(msg.Params) == 0 || json.Unmarshal(msg.Params[0], &input) != nil || tx.UnmarshalBinary(input) != nil {
+			return t.forward(req, body)
+		}
+		return t.respond(req, &jsonrpcResponse{ID: msg.ID, Result: marshalResult(tx.Hash())})
+	case FaultNonce:
+		return t.respond(req, &jsonrpcResponse{ID: msg.ID, Error: &jsonrpcError{Code: -32000, Message: core.ErrNonceTooLow.Error()}})
+	case FaultStaleHead:
+		return t.staleHead(req, body, msg)
+	case FaultReorgedLogs:
+		return t.reorgedLogs(req, body, msg)
+	}
+	return t.forward(req, body)
+}
+
+// pick returns fault to inject into the request
+func (t *FaultInjectingTransport) pick(method string, batch bool) (Fault, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for i, rule := range t.opts.Rules {
+		if !matchesRule(rule, method, batch) {
+			continue
+		}
+		t.counts[i]++
+		if (rule.Every > 0 && t.counts[i]%rule.Every == 0) || t.rand.Float64() < rule.Probability {
+			return rule.Fault, true
+		}
+	}
+	return "", false
+}
+
+// matchesRule checks if fault of the rule can be injected into the request
+func matchesRule(rule FaultRule, method string, batch bool) bool {
+	methods := faultMethods[rule.Fault]
+	if batch {
+		return methods == nil && len(rule.Methods) == 0
+	}
+	if methods != nil && !containsMethod(methods, method) {
+		return false
+	}
+	return len(rule.Methods) == 0 || containsMethod(rule.Methods, method)
+}
+
+func containsMethod(methods []string, method string) bool {
+	for _, m := range methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// staleHead serves head requests with the block StaleBlocks behind the latest one
+func (t *FaultInjectingTransport) staleHead(req *http.Request, body []byte, msg jsonrpcRequest) (*http.Response, error) {
+	if msg.Method == "eth_getBlockByNumber" && (len(msg.Params) == 0 || string(msg.Params[0]) != `"latest"`) {
+		return t.forward(req, body)
+	}
+
+	var head hexutil.Uint64
+	err := t.call(req, &head, "eth_blockNumber")
+	if err != nil {
+		return t.forward(req, body)
+	}
+	stale := uint64(0)
+	if uint64(head) > t.opts.StaleBlocks {
+		stale = uint64(head) - t.opts.StaleBlocks
+	}
+	if msg.Method == "eth_blockNumber" {
+		return t.respond(req, &jsonrpcResponse{ID: msg.ID, Result: marshalResult(hexutil.Uint64(stale))})
+	}
+
+	params := append([]json.RawMessage{marshalResult(hexutil.EncodeUint64(stale))}, msg.Params[1:]...)
+	body, err = json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": msg.ID, "method": msg.Method, "params": params})
+	if err != nil {
+		return nil, err
+	}
+	return t.forward(req, body)
+}
+
+// reorgedLogs serves event logs with block hashes unknown to the chain
+func (t *FaultInjectingTransport) reorgedLogs(req *http.Request, body []byte, msg jsonrpcRequest) (*http.Response, error) {
+	var logs []types.Log
+	err := t.call(req, &logs, msg.Method, toArgs(msg.Params)...)
+	if err != nil {
+		return t.forward(req, body)
+	}
+	for i := range logs {
+		logs[i].BlockHash = t.randomHash()
+	}
+	return t.respond(req, &jsonrpcResponse{ID: msg.ID, Result: marshalResult(logs)})
+}
+
+// call sends request to the endpoint with the context of the client request. Errors returned by the
+// endpoint are left to the client, which gets them when the original request is forwarded.
+func (t *FaultInjectingTransport) call(req *http.Request, result interface{}, method string, args ...interface{}) error {
+	client, err := rpc.DialHTTPWithClient(req.URL.String(), &http.Client{Transport: t.next})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return client.CallContext(req.Context(), result, method, args...)
+}
+
+func (t *FaultInjectingTransport) forward(req *http.Request, body []byte) (*http.Response, error) {
+	forwardReq := req.Clone(req.Context())
+	forwardReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+	forwardReq.ContentLength = int64(len(body))
+	return t.next.RoundTrip(forwardReq)
+}
+
+func (t *FaultInjectingTransport) respond(req *http.Request, res *jsonrpcResponse) (*http.Response, error) {
+	res.Version = "2.0"
+	body, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	return &http.Response{
+		Status:        http.StatusText(http.StatusOK),
+		StatusCode:    http.StatusOK,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (t *FaultInjectingTransport) randomHash() common.Hash {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var hash common.Hash
+	_, _ = t.rand.Read(hash[:])
+	return hash
+}
+
+func marshalResult(v interface{}) json.RawMessage {
+	result, _ := json.Marshal(v)
+	return result
+}
+
+// NewFaultInjectingEVMClient creates a client for EVMChain with provided signer that injects faults
+// into requests sent to the HTTP endpoint. Failed requests are retried as configured by rate limit opts.
+func NewFaultInjectingEVMClient(endpoint string, signer Signer, rateLimit RateLimitOpts, faults FaultOpts) (*EVMClient, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, fmt.Errorf("faults can be injected only into HTTP endpoints, got %s", endpoint)
+	}
+	faultTransport, err := NewFaultInjectingTransport(http.DefaultTransport, faults)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{Transport: NewRateLimitedTransport(faultTransport, strings.ToLower(u.Host), rateLimit)}
+	rpcClient, err := rpc.DialHTTPWithClient(endpoint, client)
+	if err != nil {
+		return nil, err
+	}
+	return NewEVMClientFromRPC(rpcClient, signer), nil
+}
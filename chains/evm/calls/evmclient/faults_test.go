@@ -0,0 +1,168 @@
+package evmclient_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/simulated"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/local"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/suite"
+)
+
+type FaultInjectingTransportTestSuite struct {
+	suite.Suite
+	chain     *simulated.Chain
+	server    *httptest.Server
+	rateLimit evmclient.RateLimitOpts
+	faults    evmclient.FaultOpts
+}
+
+func TestRunFaultInjectingTransportTestSuite(t *testing.T) {
+	suite.Run(t, new(FaultInjectingTransportTestSuite))
+}
+
+func (s *FaultInjectingTransportTestSuite) SetupSuite()    {}
+func (s *FaultInjectingTransportTestSuite) TearDownSuite() {}
+func (s *FaultInjectingTransportTestSuite) SetupTest() {
+	var err error
+	s.chain, err = simulated.NewChain(core.GenesisAlloc{
+		local.EveKp.CommonAddress(): {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)},
+	})
+	s.Nil(err)
+	s.server = httptest.NewServer(s.chain.Server())
+	s.rateLimit = evmclient.RateLimitOpts{}
+	s.faults = evmclient.DefaultFaultOpts
+}
+func (s *FaultInjectingTransportTestSuite) TearDownTest() {
+	s.server.Close()
+	_ = s.chain.Close()
+}
+
+func (s *FaultInjectingTransportTestSuite) client(rules ...evmclient.FaultRule) *evmclient.EVMClient {
+	s.faults.Rules = rules
+	client, err := evmclient.NewFaultInjectingEVMClient(s.server.URL, local.EveKp, s.rateLimit, s.faults)
+	s.Nil(err)
+	return client
+}
+
+func (s *FaultInjectingTransportTestSuite) sendTransfer(client *evmclient.EVMClient) error {
+	to := local.BobKp.CommonAddress()
+	tx, err := evmtransaction.NewTransaction(0, &to, big.NewInt(1), 21000, []*big.Int{big.NewInt(10000000000)}, nil)
+	s.Nil(err)
+	_, err = client.SignAndSendTransaction(context.Background(), tx)
+	return err
+}
+
+func (s *FaultInjectingTransportTestSuite) TestNewFaultInjectingTransport_UnsupportedFault() {
+	_, err := evmclient.NewFaultInjectingTransport(http.DefaultTransport, evmclient.FaultOpts{
+		Rules: []evmclient.FaultRule{{Fault: "invalid"}},
+	})
+
+	s.NotNil(err)
+}
+
+func (s *FaultInjectingTransportTestSuite) TestNoRules_RequestsForwarded() {
+	s.chain.Mine(3)
+	client := s.client()
+
+	head, err := client.LatestBlock()
+
+	s.Nil(err)
+	s.Equal(big.NewInt(3), head)
+}
+
+func (s *FaultInjectingTransportTestSuite) TestServerError_ScheduledFaultFailsEveryNthRequest() {
+	client := s.client(evmclient.FaultRule{Fault: evmclient.FaultServerError, Every: 2})
+
+	_, err := client.LatestBlock()
+	s.Nil(err)
+	_, err = client.LatestBlock()
+	s.NotNil(err)
+	_, err = client.LatestBlock()
+	s.Nil(err)
+}
+
+func (s *FaultInjectingTransportTestSuite) TestServerError_RetriedByRateLimitedTransport() {
+	s.rateLimit.MaxRetries = 1
+	client := s.client(evmclient.FaultRule{Fault: evmclient.FaultServerError, Every: 2})
+
+	_, err := client.LatestBlock()
+	s.Nil(err)
+	_, err = client.LatestBlock()
+	s.Nil(err)
+}
+
+func (s *FaultInjectingTransportTestSuite) TestTimeout_RequestFailsAfterTimeout() {
+	s.faults.Timeout = 10 * time.Millisecond
+	client := s.client(evmclient.FaultRule{Fault: evmclient.FaultTimeout, Probability: 1})
+
+	_, err := client.LatestBlock()
+
+	s.ErrorIs(err, evmclient.ErrInjectedTimeout)
+}
+
+func (s *FaultInjectingTransportTestSuite) TestTimeout_LimitedToMethods() {
+	s.faults.Timeout = 10 * time.Millisecond
+	client := s.client(evmclient.FaultRule{Fault: evmclient.FaultTimeout, Probability: 1, Methods: []string{"eth_getLogs"}})
+
+	_, err := client.LatestBlock()
+
+	s.Nil(err)
+}
+
+func (s *FaultInjectingTransportTestSuite) TestDroppedTransaction_NotSentToEndpoint() {
+	client := s.client(evmclient.FaultRule{Fault: evmclient.FaultDroppedTransaction, Probability: 1})
+
+	err := s.sendTransfer(client)
+
+	s.Nil(err)
+	nonce, err := s.chain.Backend().PendingNonceAt(context.Background(), local.EveKp.CommonAddress())
+	s.Nil(err)
+	s.Equal(uint64(0), nonce)
+}
+
+func (s *FaultInjectingTransportTestSuite) TestNonce_TransactionRejected() {
+	client := s.client(evmclient.FaultRule{Fault: evmclient.FaultNonce, Probability: 1})
+
+	err := s.sendTransfer(client)
+
+	s.True(evmclient.IsNonceError(err))
+}
+
+func (s *FaultInjectingTransportTestSuite) TestStaleHead_HeadBehindLatestBlock() {
+	s.chain.Mine(10)
+	s.faults.StaleBlocks = 4
+	client := s.client(evmclient.FaultRule{Fault: evmclient.FaultStaleHead, Probability: 1})
+
+	head, err := client.LatestBlock()
+	s.Nil(err)
+	number, err := client.BlockNumber(context.Background())
+	s.Nil(err)
+
+	s.Equal(big.NewInt(6), head)
+	s.Equal(uint64(6), number)
+}
+
+func (s *FaultInjectingTransportTestSuite) TestReorgedLogs_LogsFromUnknownBlocks() {
+	conf, err := local.SetupEVMBridge(s.chain.Client(local.EveKp), evmtransaction.NewTransaction, 1, big.NewInt(1), local.EveKp.CommonAddress(), local.DefaultRelayerAddresses)
+	s.Nil(err)
+	client := s.client(evmclient.FaultRule{Fault: evmclient.FaultReorgedLogs, Probability: 1})
+	head, err := client.LatestBlock()
+	s.Nil(err)
+
+	logs, err := client.FetchEventLogs(context.Background(), conf.BridgeAddr, string(events.ThresholdChangedSig), big.NewInt(0), head)
+
+	s.Nil(err)
+	s.Len(logs, 1)
+	block, err := s.chain.Backend().BlockByNumber(context.Background(), new(big.Int).SetUint64(logs[0].BlockNumber))
+	s.Nil(err)
+	s.NotEqual(block.Hash(), logs[0].BlockHash)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/calls/evmclient/nonce.go
+
+// Package mock_evmclient is a generated GoMock package.
+package mock_evmclient
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNonceClient is a mock of NonceClient interface.
+type MockNonceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockNonceClientMockRecorder
+}
+
+// MockNonceClientMockRecorder is the mock recorder for MockNonceClient.
+type MockNonceClientMockRecorder struct {
+	mock *MockNonceClient
+}
+
+// NewMockNonceClient creates a new mock instance.
+func NewMockNonceClient(ctrl *gomock.Controller) *MockNonceClient {
+	mock := &MockNonceClient{ctrl: ctrl}
+	mock.recorder = &MockNonceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNonceClient) EXPECT() *MockNonceClientMockRecorder {
+	return m.recorder
+}
+
+// NonceAt mocks base method.
+func (m *MockNonceClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonceAt", ctx, account, blockNumber)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NonceAt indicates an expected call of NonceAt.
+func (mr *MockNonceClientMockRecorder) NonceAt(ctx, account, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonceAt", reflect.TypeOf((*MockNonceClient)(nil).NonceAt), ctx, account, blockNumber)
+}
+
+// PendingNonceAt mocks base method.
+func (m *MockNonceClient) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingNonceAt", ctx, account)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingNonceAt indicates an expected call of PendingNonceAt.
+func (mr *MockNonceClientMockRecorder) PendingNonceAt(ctx, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingNonceAt", reflect.TypeOf((*MockNonceClient)(nil).PendingNonceAt), ctx, account)
+}
+
+// MockNonceStorer is a mock of NonceStorer interface.
+type MockNonceStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockNonceStorerMockRecorder
+}
+
+// MockNonceStorerMockRecorder is the mock recorder for MockNonceStorer.
+type MockNonceStorerMockRecorder struct {
+	mock *MockNonceStorer
+}
+
+// NewMockNonceStorer creates a new mock instance.
+func NewMockNonceStorer(ctrl *gomock.Controller) *MockNonceStorer {
+	mock := &MockNonceStorer{ctrl: ctrl}
+	mock.recorder = &MockNonceStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNonceStorer) EXPECT() *MockNonceStorerMockRecorder {
+	return m.recorder
+}
+
+// GetAccountNonce mocks base method.
+func (m *MockNonceStorer) GetAccountNonce(chainID *big.Int, account common.Address) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountNonce", chainID, account)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountNonce indicates an expected call of GetAccountNonce.
+func (mr *MockNonceStorerMockRecorder) GetAccountNonce(chainID, account interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountNonce", reflect.TypeOf((*MockNonceStorer)(nil).GetAccountNonce), chainID, account)
+}
+
+// StoreAccountNonce mocks base method.
+func (m *MockNonceStorer) StoreAccountNonce(chainID *big.Int, account common.Address, nonce *big.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreAccountNonce", chainID, account, nonce)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreAccountNonce indicates an expected call of StoreAccountNonce.
+func (mr *MockNonceStorerMockRecorder) StoreAccountNonce(chainID, account, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreAccountNonce", reflect.TypeOf((*MockNonceStorer)(nil).StoreAccountNonce), chainID, account, nonce)
+}
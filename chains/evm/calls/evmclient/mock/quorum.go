@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/calls/evmclient/quorum.go
+
+// Package mock_evmclient is a generated GoMock package.
+package mock_evmclient
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockQuorumReader is a mock of QuorumReader interface.
+type MockQuorumReader struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuorumReaderMockRecorder
+}
+
+// MockQuorumReaderMockRecorder is the mock recorder for MockQuorumReader.
+type MockQuorumReaderMockRecorder struct {
+	mock *MockQuorumReader
+}
+
+// NewMockQuorumReader creates a new mock instance.
+func NewMockQuorumReader(ctrl *gomock.Controller) *MockQuorumReader {
+	mock := &MockQuorumReader{ctrl: ctrl}
+	mock.recorder = &MockQuorumReaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuorumReader) EXPECT() *MockQuorumReaderMockRecorder {
+	return m.recorder
+}
+
+// CallContract mocks base method.
+func (m *MockQuorumReader) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CallContract", ctx, callArgs, blockNumber)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CallContract indicates an expected call of CallContract.
+func (mr *MockQuorumReaderMockRecorder) CallContract(ctx, callArgs, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CallContract", reflect.TypeOf((*MockQuorumReader)(nil).CallContract), ctx, callArgs, blockNumber)
+}
+
+// FetchLogs mocks base method.
+func (m *MockQuorumReader) FetchLogs(ctx context.Context, contractAddress common.Address, events []string, startBlock, endBlock *big.Int) ([]types.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchLogs", ctx, contractAddress, events, startBlock, endBlock)
+	ret0, _ := ret[0].([]types.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchLogs indicates an expected call of FetchLogs.
+func (mr *MockQuorumReaderMockRecorder) FetchLogs(ctx, contractAddress, events, startBlock, endBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchLogs", reflect.TypeOf((*MockQuorumReader)(nil).FetchLogs), ctx, contractAddress, events, startBlock, endBlock)
+}
+
+// LatestBlock mocks base method.
+func (m *MockQuorumReader) LatestBlock() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestBlock")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestBlock indicates an expected call of LatestBlock.
+func (mr *MockQuorumReaderMockRecorder) LatestBlock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestBlock", reflect.TypeOf((*MockQuorumReader)(nil).LatestBlock))
+}
+
+// MockDisagreementTracker is a mock of DisagreementTracker interface.
+type MockDisagreementTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisagreementTrackerMockRecorder
+}
+
+// MockDisagreementTrackerMockRecorder is the mock recorder for MockDisagreementTracker.
+type MockDisagreementTrackerMockRecorder struct {
+	mock *MockDisagreementTracker
+}
+
+// NewMockDisagreementTracker creates a new mock instance.
+func NewMockDisagreementTracker(ctrl *gomock.Controller) *MockDisagreementTracker {
+	mock := &MockDisagreementTracker{ctrl: ctrl}
+	mock.recorder = &MockDisagreementTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisagreementTracker) EXPECT() *MockDisagreementTrackerMockRecorder {
+	return m.recorder
+}
+
+// TrackQuorumDisagreement mocks base method.
+func (m *MockDisagreementTracker) TrackQuorumDisagreement(domainID uint8, method string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackQuorumDisagreement", domainID, method)
+}
+
+// TrackQuorumDisagreement indicates an expected call of TrackQuorumDisagreement.
+func (mr *MockDisagreementTrackerMockRecorder) TrackQuorumDisagreement(domainID, method interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackQuorumDisagreement", reflect.TypeOf((*MockDisagreementTracker)(nil).TrackQuorumDisagreement), domainID, method)
+}
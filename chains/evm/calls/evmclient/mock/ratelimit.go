@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/calls/evmclient/ratelimit.go
+
+// Package mock_evmclient is a generated GoMock package.
+package mock_evmclient
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRetryMetrics is a mock of RetryMetrics interface.
+type MockRetryMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetryMetricsMockRecorder
+}
+
+// MockRetryMetricsMockRecorder is the mock recorder for MockRetryMetrics.
+type MockRetryMetricsMockRecorder struct {
+	mock *MockRetryMetrics
+}
+
+// NewMockRetryMetrics creates a new mock instance.
+func NewMockRetryMetrics(ctrl *gomock.Controller) *MockRetryMetrics {
+	mock := &MockRetryMetrics{ctrl: ctrl}
+	mock.recorder = &MockRetryMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetryMetrics) EXPECT() *MockRetryMetricsMockRecorder {
+	return m.recorder
+}
+
+// TrackRPCRetry mocks base method.
+func (m *MockRetryMetrics) TrackRPCRetry(endpoint string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackRPCRetry", endpoint)
+}
+
+// TrackRPCRetry indicates an expected call of TrackRPCRetry.
+func (mr *MockRetryMetricsMockRecorder) TrackRPCRetry(endpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackRPCRetry", reflect.TypeOf((*MockRetryMetrics)(nil).TrackRPCRetry), endpoint)
+}
+
+// TrackRPCThrottle mocks base method.
+func (m *MockRetryMetrics) TrackRPCThrottle(endpoint string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackRPCThrottle", endpoint)
+}
+
+// TrackRPCThrottle indicates an expected call of TrackRPCThrottle.
+func (mr *MockRetryMetricsMockRecorder) TrackRPCThrottle(endpoint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackRPCThrottle", reflect.TypeOf((*MockRetryMetrics)(nil).TrackRPCThrottle), endpoint)
+}
@@ -0,0 +1,152 @@
+package evmclient
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type NonceClient interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+type NonceStorer interface {
+	StoreAccountNonce(chainID *big.Int, account common.Address, nonce *big.Int) error
+	GetAccountNonce(chainID *big.Int, account common.Address) (*big.Int, error)
+}
+
+// NonceManager tracks next nonce of the account. It reconciles tracked nonce with the
+// node when nonce errors occur and optionally persists it so that nonces are not reused after restart.
+type NonceManager struct {
+	client  NonceClient
+	account common.Address
+	store   NonceStorer
+	chainID *big.Int
+
+	lock  sync.Mutex
+	nonce *big.Int
+}
+
+func NewNonceManager(client NonceClient, account common.Address) *NonceManager {
+	return &NonceManager{
+		client:  client,
+		account: account,
+	}
+}
+
+// SetStore sets store next nonce is persisted to after every change
+func (m *NonceManager) SetStore(store NonceStorer, chainID *big.Int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.store = store
+	m.chainID = chainID
+}
+
+// Nonce returns next nonce of the account. On first use it is initialized from node pending
+// nonce, or from the stored nonce if it is higher. Gaps left below the stored nonce are returned by Reconcile.
+func (m *NonceManager) Nonce() (*big.Int, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.nonce != nil {
+		return m.nonce, nil
+	}
+
+	pending, err := m.client.PendingNonceAt(context.Background(), m.account)
+	if err != nil {
+		return nil, err
+	}
+	nonce := new(big.Int).SetUint64(pending)
+	if m.store != nil {
+		stored, err := m.store.GetAccountNonce(m.chainID, m.account)
+		if err != nil {
+			return nil, err
+		}
+		if stored.Cmp(nonce) > 0 {
+			log.Warn().Msgf("Stored nonce %s of %s is higher than pending nonce %s, gaps have to be filled", stored, m.account, nonce)
+			nonce = stored
+		}
+	}
+	m.nonce = nonce
+	return m.nonce, nil
+}
+
+// Increase increases next nonce of the account after transaction was sent
+func (m *NonceManager) Increase() error {
+	_, err := m.Nonce()
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.nonce = new(big.Int).Add(m.nonce, big.NewInt(1))
+	return m.persist()
+}
+
+// Reconcile compares tracked nonce with the node account nonces. If transactions were sent with
+// the account from elsewhere tracked nonce is moved forward to pending nonce. Returns gaps, nonces
+// lower than the tracked nonce that were neither mined nor are known to the node, which have to be
+// filled for later transactions to be mined.
+func (m *NonceManager) Reconcile() ([]uint64, error) {
+	latest, err := m.client.NonceAt(context.Background(), m.account, nil)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := m.client.PendingNonceAt(context.Background(), m.account)
+	if err != nil {
+		return nil, err
+	}
+	if pending < latest {
+		pending = latest
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.nonce == nil || m.nonce.Uint64() < pending {
+		log.Info().Msgf("Reconciled nonce of %s from %v to %d", m.account, m.nonce, pending)
+		m.nonce = new(big.Int).SetUint64(pending)
+		return []uint64{}, m.persist()
+	}
+
+	gaps := make([]uint64, 0)
+	for n := pending; n < m.nonce.Uint64(); n++ {
+		gaps = append(gaps, n)
+	}
+	return gaps, nil
+}
+
+func (m *NonceManager) persist() error {
+	if m.store == nil {
+		return nil
+	}
+	return m.store.StoreAccountNonce(m.chainID, m.account, m.nonce)
+}
+
+// IsNonceError returns true if transaction was rejected by node because of its nonce
+func IsNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "nonce too low") ||
+		strings.Contains(msg, "nonce too high") ||
+		strings.Contains(msg, "invalid nonce")
+}
+
+// IsAlreadyKnownError returns true if transaction was rejected by node because it is already in its pool
+func IsAlreadyKnownError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "already known") ||
+		strings.Contains(msg, "known transaction")
+}
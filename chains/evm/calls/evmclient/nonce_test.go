@@ -0,0 +1,117 @@
+package evmclient_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	mock_evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	account = common.HexToAddress("0x5")
+	chainID = big.NewInt(1)
+)
+
+type NonceManagerTestSuite struct {
+	suite.Suite
+	nonceManager    *evmclient.NonceManager
+	mockNonceClient *mock_evmclient.MockNonceClient
+	mockNonceStorer *mock_evmclient.MockNonceStorer
+}
+
+func TestRunNonceManagerTestSuite(t *testing.T) {
+	suite.Run(t, new(NonceManagerTestSuite))
+}
+
+func (s *NonceManagerTestSuite) SetupSuite()    {}
+func (s *NonceManagerTestSuite) TearDownSuite() {}
+func (s *NonceManagerTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockNonceClient = mock_evmclient.NewMockNonceClient(gomockController)
+	s.mockNonceStorer = mock_evmclient.NewMockNonceStorer(gomockController)
+	s.nonceManager = evmclient.NewNonceManager(s.mockNonceClient, account)
+	s.nonceManager.SetStore(s.mockNonceStorer, chainID)
+}
+func (s *NonceManagerTestSuite) TearDownTest() {}
+
+func (s *NonceManagerTestSuite) TestNonce_PendingNonceFails() {
+	s.mockNonceClient.EXPECT().PendingNonceAt(gomock.Any(), account).Return(uint64(0), errors.New("error"))
+
+	_, err := s.nonceManager.Nonce()
+
+	s.NotNil(err)
+}
+
+func (s *NonceManagerTestSuite) TestNonce_InitializedFromPendingNonce() {
+	s.mockNonceClient.EXPECT().PendingNonceAt(gomock.Any(), account).Return(uint64(5), nil)
+	s.mockNonceStorer.EXPECT().GetAccountNonce(chainID, account).Return(big.NewInt(3), nil)
+
+	nonce, err := s.nonceManager.Nonce()
+	s.Nil(err)
+	s.Equal(big.NewInt(5), nonce)
+
+	// nonce is cached after first fetch
+	nonce, err = s.nonceManager.Nonce()
+	s.Nil(err)
+	s.Equal(big.NewInt(5), nonce)
+}
+
+func (s *NonceManagerTestSuite) TestNonce_StoredNonceHigherThanPending() {
+	s.mockNonceClient.EXPECT().PendingNonceAt(gomock.Any(), account).Return(uint64(5), nil)
+	s.mockNonceStorer.EXPECT().GetAccountNonce(chainID, account).Return(big.NewInt(8), nil)
+
+	nonce, err := s.nonceManager.Nonce()
+
+	s.Nil(err)
+	s.Equal(big.NewInt(8), nonce)
+}
+
+func (s *NonceManagerTestSuite) TestIncrease_PersistsNonce() {
+	s.mockNonceClient.EXPECT().PendingNonceAt(gomock.Any(), account).Return(uint64(5), nil)
+	s.mockNonceStorer.EXPECT().GetAccountNonce(chainID, account).Return(big.NewInt(0), nil)
+	s.mockNonceStorer.EXPECT().StoreAccountNonce(chainID, account, big.NewInt(6)).Return(nil)
+
+	err := s.nonceManager.Increase()
+	s.Nil(err)
+
+	nonce, _ := s.nonceManager.Nonce()
+	s.Equal(big.NewInt(6), nonce)
+}
+
+func (s *NonceManagerTestSuite) TestReconcile_NonceTooLow() {
+	s.mockNonceClient.EXPECT().NonceAt(gomock.Any(), account, nil).Return(uint64(9), nil)
+	s.mockNonceClient.EXPECT().PendingNonceAt(gomock.Any(), account).Return(uint64(10), nil)
+	s.mockNonceStorer.EXPECT().StoreAccountNonce(chainID, account, big.NewInt(10)).Return(nil)
+
+	gaps, err := s.nonceManager.Reconcile()
+	s.Nil(err)
+	s.Empty(gaps)
+
+	nonce, _ := s.nonceManager.Nonce()
+	s.Equal(big.NewInt(10), nonce)
+}
+
+func (s *NonceManagerTestSuite) TestReconcile_Gaps() {
+	s.mockNonceClient.EXPECT().PendingNonceAt(gomock.Any(), account).Return(uint64(5), nil)
+	s.mockNonceStorer.EXPECT().GetAccountNonce(chainID, account).Return(big.NewInt(8), nil)
+	_, _ = s.nonceManager.Nonce()
+	s.mockNonceClient.EXPECT().NonceAt(gomock.Any(), account, nil).Return(uint64(4), nil)
+	s.mockNonceClient.EXPECT().PendingNonceAt(gomock.Any(), account).Return(uint64(5), nil)
+
+	gaps, err := s.nonceManager.Reconcile()
+
+	s.Nil(err)
+	s.Equal([]uint64{5, 6, 7}, gaps)
+}
+
+func (s *NonceManagerTestSuite) TestIsNonceError() {
+	s.True(evmclient.IsNonceError(errors.New("nonce too low")))
+	s.True(evmclient.IsNonceError(errors.New("Nonce too high")))
+	s.False(evmclient.IsNonceError(errors.New("replacement transaction underpriced")))
+	s.False(evmclient.IsNonceError(nil))
+}
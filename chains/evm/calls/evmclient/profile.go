@@ -0,0 +1,185 @@
+package evmclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+const errCodeMethodNotFound = -32601
+
+// logRangeProbes are block ranges event log queries are probed with, from the largest
+var logRangeProbes = []uint64{10000, 2000, 1000, 500, 100}
+
+// ChainProfile describes capabilities of the chain and of its endpoint. It is probed once
+// when the relayer starts so that components don't have to discover them by trial.
+type ChainProfile struct {
+	// ChainID is nil if the chain does not support chain IDs
+	ChainID *big.Int
+	// EIP1559 is true if blocks of the chain have base fee
+	EIP1559 bool
+	// FinalityTag is true if the endpoint resolves finalized block tag
+	FinalityTag bool
+	// MaxLogRange is the largest block range event logs can be queried for. If 0 - not limited
+	// up to the largest probed range
+	MaxLogRange uint64
+	// Subscriptions is true if the endpoint supports pending transaction subscriptions
+	Subscriptions bool
+	ClientVersion string
+}
+
+// ProbeProfile probes capabilities of the chain and caches them in the client. Client uses
+// the profile instead of querying them again.
+func (c *EVMClient) ProbeProfile(ctx context.Context) (*ChainProfile, error) {
+	profile := &ChainProfile{}
+
+	id, err := c.ChainID(ctx)
+	if err != nil && !isMethodNotFound(err) {
+		return nil, fmt.Errorf("failed fetching chain ID: %w", err)
+	}
+	profile.ChainID = id
+
+	head, err := c.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching head: %w", err)
+	}
+	profile.EIP1559 = head.BaseFee != nil
+
+	var finalized *headerNumber
+	err = c.rpClient.CallContext(ctx, &finalized, "eth_getBlockByNumber", "finalized", false)
+	profile.FinalityTag = err == nil && finalized != nil
+
+	profile.MaxLogRange, err = c.probeLogRange(ctx, head.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan common.Hash)
+	sub, err := c.SubscribePendingTransactions(ctx, ch)
+	if err == nil {
+		sub.Unsubscribe()
+		profile.Subscriptions = true
+	}
+
+	err = c.rpClient.CallContext(ctx, &profile.ClientVersion, "web3_clientVersion")
+	if err != nil {
+		log.Debug().Err(err).Msg("Failed fetching client version")
+	}
+
+	log.Info().
+		Str("chainID", fmt.Sprint(profile.ChainID)).
+		Bool("eip1559", profile.EIP1559).
+		Bool("finalityTag", profile.FinalityTag).
+		Uint64("maxLogRange", profile.MaxLogRange).
+		Bool("subscriptions", profile.Subscriptions).
+		Str("clientVersion", profile.ClientVersion).
+		Msg("Probed chain profile")
+	c.profile = profile
+	return profile, nil
+}
+
+// Profile returns profile of the chain. It is nil if the chain was not probed.
+func (c *EVMClient) Profile() *ChainProfile {
+	return c.profile
+}
+
+// probeLogRange finds the largest of probed block ranges up to the head event logs can be queried for.
+// Ranges longer than the chain can't be probed, so on young chains the range is limited to the longest
+// probed one that fits. Smaller ranges are only probed when the query fails on the endpoint range limits,
+// and if every probed range is rejected the smallest one is halved until the endpoint accepts it.
+func (c *EVMClient) probeLogRange(ctx context.Context, head uint64) (uint64, error) {
+	var rejected uint64
+	for i, blocks := range logRangeProbes {
+		if blocks > head+1 {
+			continue
+		}
+		err := c.queryLogRange(ctx, head, blocks)
+		if isLogRangeError(err) {
+			rejected = blocks
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed querying event logs: %w", err)
+		}
+		if i == 0 {
+			return 0, nil
+		}
+		return blocks, nil
+	}
+	if rejected == 0 {
+		return 0, nil
+	}
+
+	blocks := rejected
+	for blocks > 1 {
+		blocks /= 2
+		err := c.queryLogRange(ctx, head, blocks)
+		if isLogRangeError(err) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed querying event logs: %w", err)
+		}
+		return blocks, nil
+	}
+	log.Warn().Msg("Endpoint rejected event log queries of all probed ranges, limiting them to a single block")
+	return 1, nil
+}
+
+// queryLogRange queries event logs of the last blocks up to the head
+func (c *EVMClient) queryLogRange(ctx context.Context, head uint64, blocks uint64) error {
+	_, err := c.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(head + 1 - blocks),
+		ToBlock:   new(big.Int).SetUint64(head),
+		Addresses: []common.Address{{}},
+	})
+	return err
+}
+
+// chainID returns chain ID from the profile if the chain was probed
+func (c *EVMClient) chainID(ctx context.Context) (*big.Int, error) {
+	if c.profile != nil {
+		return c.profile.ChainID, nil
+	}
+	return c.ChainID(ctx)
+}
+
+// logRanges splits block range into ranges event logs can be queried for
+func (c *EVMClient) logRanges(startBlock *big.Int, endBlock *big.Int) [][2]*big.Int {
+	if c.profile == nil || c.profile.MaxLogRange == 0 || startBlock == nil || endBlock == nil {
+		return [][2]*big.Int{{startBlock, endBlock}}
+	}
+
+	maxRange := new(big.Int).SetUint64(c.profile.MaxLogRange)
+	ranges := make([][2]*big.Int, 0)
+	for from := new(big.Int).Set(startBlock); from.Cmp(endBlock) <= 0; from = new(big.Int).Add(from, maxRange) {
+		to := new(big.Int).Add(from, maxRange)
+		to.Sub(to, big.NewInt(1))
+		if to.Cmp(endBlock) > 0 {
+			to = endBlock
+		}
+		ranges = append(ranges, [2]*big.Int{from, to})
+	}
+	return ranges
+}
+
+// isLogRangeError checks if event log query failed on the endpoint block range or result limits.
+// Throttled queries are retried by the transport, so limit exceeded errors are range errors here.
+func isLogRangeError(err error) bool {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == errCodeLimitExceeded {
+		return true
+	}
+	return err != nil && isQueryLimitMessage(err.Error())
+}
+
+func isMethodNotFound(err error) bool {
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) && rpcErr.ErrorCode() == errCodeMethodNotFound
+}
@@ -0,0 +1,152 @@
+package evmclient_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/simulated"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/local"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/suite"
+)
+
+type ChainProfileTestSuite struct {
+	suite.Suite
+	chain       *simulated.Chain
+	server      *httptest.Server
+	maxLogRange uint64
+	logError    string
+	logQueries  int
+}
+
+func TestRunChainProfileTestSuite(t *testing.T) {
+	suite.Run(t, new(ChainProfileTestSuite))
+}
+
+func (s *ChainProfileTestSuite) SetupSuite()    {}
+func (s *ChainProfileTestSuite) TearDownSuite() {}
+func (s *ChainProfileTestSuite) SetupTest() {
+	var err error
+	s.chain, err = simulated.NewChain(core.GenesisAlloc{
+		local.EveKp.CommonAddress(): {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)},
+	})
+	s.Nil(err)
+	s.maxLogRange = 0
+	s.logError = ""
+	s.logQueries = 0
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+}
+func (s *ChainProfileTestSuite) TearDownTest() {
+	s.server.Close()
+	_ = s.chain.Close()
+}
+
+// serve forwards requests to the simulated chain, rejecting event log queries over maxLogRange blocks
+// and failing all of them with logError if set
+func (s *ChainProfileTestSuite) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	var msg struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+		Params []struct {
+			FromBlock hexutil.Uint64 `json:"fromBlock"`
+			ToBlock   hexutil.Uint64 `json:"toBlock"`
+		} `json:"params"`
+	}
+	if json.Unmarshal(body, &msg) == nil && msg.Method == "eth_getLogs" && len(msg.Params) > 0 {
+		s.logQueries++
+		if s.logError != "" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(msg.ID) + `,"error":{"code":-32000,"message":"` + s.logError + `"}}`))
+			return
+		}
+		if s.maxLogRange > 0 && uint64(msg.Params[0].ToBlock-msg.Params[0].FromBlock)+1 > s.maxLogRange {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":` + string(msg.ID) + `,"error":{"code":-32005,"message":"block range too large"}}`))
+			return
+		}
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	s.chain.Server().ServeHTTP(w, r)
+}
+
+func (s *ChainProfileTestSuite) client() *evmclient.EVMClient {
+	client, err := evmclient.NewEVMClient(s.server.URL, local.EveKp)
+	s.Nil(err)
+	return client
+}
+
+func (s *ChainProfileTestSuite) TestProbeProfile_ChainCapabilities() {
+	client := s.client()
+
+	profile, err := client.ProbeProfile(context.Background())
+
+	s.Nil(err)
+	s.Equal(big.NewInt(1337), profile.ChainID)
+	s.True(profile.EIP1559)
+	s.False(profile.FinalityTag)
+	s.Equal(uint64(0), profile.MaxLogRange)
+	s.False(profile.Subscriptions)
+	s.Equal("", profile.ClientVersion)
+	s.Equal(profile, client.Profile())
+}
+
+func (s *ChainProfileTestSuite) TestProbeProfile_LimitedLogRange() {
+	s.chain.Mine(2000)
+	s.maxLogRange = 1000
+	client := s.client()
+
+	profile, err := client.ProbeProfile(context.Background())
+
+	s.Nil(err)
+	s.Equal(uint64(1000), profile.MaxLogRange)
+}
+
+func (s *ChainProfileTestSuite) TestProbeProfile_RangeBelowProbesHalvedUntilAccepted() {
+	s.chain.Mine(2000)
+	s.maxLogRange = 30
+	client := s.client()
+
+	profile, err := client.ProbeProfile(context.Background())
+
+	s.Nil(err)
+	s.Equal(uint64(25), profile.MaxLogRange)
+}
+
+func (s *ChainProfileTestSuite) TestProbeProfile_LogQueryFailureNotProbedWithSmallerRange() {
+	s.chain.Mine(2000)
+	s.logError = "request timed out"
+	client := s.client()
+
+	_, err := client.ProbeProfile(context.Background())
+
+	s.NotNil(err)
+	s.Equal(1, s.logQueries)
+}
+
+func (s *ChainProfileTestSuite) TestFetchEventLogs_QueriedInRangesOfProfile() {
+	conf, err := local.SetupEVMBridge(s.chain.Client(local.EveKp), evmtransaction.NewTransaction, 1, big.NewInt(1), local.EveKp.CommonAddress(), local.DefaultRelayerAddresses)
+	s.Nil(err)
+	s.chain.Mine(500)
+	s.maxLogRange = 100
+	client := s.client()
+	_, err = client.ProbeProfile(context.Background())
+	s.Nil(err)
+	s.logQueries = 0
+
+	logs, err := client.FetchEventLogs(context.Background(), conf.BridgeAddr, string(events.ThresholdChangedSig), big.NewInt(0), big.NewInt(250))
+
+	s.Nil(err)
+	s.Len(logs, 1)
+	s.Equal(3, s.logQueries)
+}
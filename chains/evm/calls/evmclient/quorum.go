@@ -0,0 +1,177 @@
+package evmclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrNoQuorum = errors.New("endpoints did not reach quorum")
+
+// QuorumReader is an endpoint queried by QuorumClient
+type QuorumReader interface {
+	LatestBlock() (*big.Int, error)
+	CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error)
+	FetchLogs(ctx context.Context, contractAddress common.Address, events []string, startBlock *big.Int, endBlock *big.Int) ([]types.Log, error)
+}
+
+type DisagreementTracker interface {
+	TrackQuorumDisagreement(domainID uint8, method string)
+}
+
+// QuorumClient is EVMClient that accepts results of contract calls and event log queries
+// only if at least quorum of the readers returned the same result. Other requests and
+// transactions are sent with the embedded client.
+type QuorumClient struct {
+	*EVMClient
+	readers  []QuorumReader
+	quorum   int
+	domainID uint8
+	tracker  DisagreementTracker
+}
+
+func NewQuorumClient(client *EVMClient, readers []QuorumReader, quorum int, domainID uint8, tracker DisagreementTracker) (*QuorumClient, error) {
+	if quorum < 1 || quorum > len(readers) {
+		return nil, fmt.Errorf("quorum %d invalid for %d endpoints", quorum, len(readers))
+	}
+	return &QuorumClient{
+		EVMClient: client,
+		readers:   readers,
+		quorum:    quorum,
+		domainID:  domainID,
+		tracker:   tracker,
+	}, nil
+}
+
+// CallContract calls contract on all readers. Calls on the latest block are made
+// on the highest block that at least quorum of the readers have.
+func (c *QuorumClient) CallContract(ctx context.Context, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
+	if blockNumber == nil {
+		var err error
+		blockNumber, err = c.quorumBlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := c.query("eth_call", func(r QuorumReader) (interface{}, string, error) {
+		out, err := r.CallContract(ctx, callArgs, blockNumber)
+		return out, common.Bytes2Hex(out), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.([]byte), nil
+}
+
+func (c *QuorumClient) FetchEventLogs(ctx context.Context, contractAddress common.Address, event string, startBlock *big.Int, endBlock *big.Int) ([]types.Log, error) {
+	return c.FetchLogs(ctx, contractAddress, []string{event}, startBlock, endBlock)
+}
+
+func (c *QuorumClient) FetchLogs(ctx context.Context, contractAddress common.Address, events []string, startBlock *big.Int, endBlock *big.Int) ([]types.Log, error) {
+	res, err := c.query("eth_getLogs", func(r QuorumReader) (interface{}, string, error) {
+		logs, err := r.FetchLogs(ctx, contractAddress, events, startBlock, endBlock)
+		if err != nil {
+			return nil, "", err
+		}
+		key, err := json.Marshal(logs)
+		return logs, string(key), err
+	})
+	if err != nil {
+		return []types.Log{}, err
+	}
+	return res.([]types.Log), nil
+}
+
+// quorumBlock returns the highest block that at least quorum of the readers have
+func (c *QuorumClient) quorumBlock() (*big.Int, error) {
+	heads := make([]*big.Int, len(c.readers))
+	wg := sync.WaitGroup{}
+	for i, r := range c.readers {
+		wg.Add(1)
+		go func(i int, r QuorumReader) {
+			defer wg.Done()
+			head, err := r.LatestBlock()
+			if err != nil {
+				log.Warn().Err(err).Msg("Failed fetching latest block of quorum endpoint")
+				return
+			}
+			heads[i] = head
+		}(i, r)
+	}
+	wg.Wait()
+
+	available := make([]*big.Int, 0, len(heads))
+	for _, head := range heads {
+		if head != nil {
+			available = append(available, head)
+		}
+	}
+	if len(available) < c.quorum {
+		return nil, fmt.Errorf("%w: %d of %d endpoints returned latest block, %d required", ErrNoQuorum, len(available), len(c.readers), c.quorum)
+	}
+	sort.Slice(available, func(i, j int) bool { return available[i].Cmp(available[j]) > 0 })
+	return available[c.quorum-1], nil
+}
+
+// query sends request to all readers and returns result with the same key returned by at
+// least quorum of them. Readers that failed or returned a different result are reported as disagreement.
+func (c *QuorumClient) query(method string, request func(r QuorumReader) (interface{}, string, error)) (interface{}, error) {
+	type result struct {
+		value interface{}
+		key   string
+		err   error
+	}
+	results := make([]result, len(c.readers))
+	wg := sync.WaitGroup{}
+	for i, r := range c.readers {
+		wg.Add(1)
+		go func(i int, r QuorumReader) {
+			defer wg.Done()
+			value, key, err := request(r)
+			results[i] = result{value: value, key: key, err: err}
+		}(i, r)
+	}
+	wg.Wait()
+
+	counts := make(map[string]int)
+	failed := 0
+	var best *result
+	for i, res := range results {
+		if res.err != nil {
+			log.Debug().Err(res.err).Msgf("Quorum endpoint %d failed %s", i, method)
+			failed++
+			continue
+		}
+		counts[res.key]++
+		if best == nil || counts[res.key] > counts[best.key] {
+			best = &results[i]
+		}
+	}
+
+	if len(counts) > 1 || failed > 0 {
+		log.Warn().
+			Uint8("domainID", c.domainID).
+			Int("results", len(counts)).
+			Int("failed", failed).
+			Msgf("Quorum endpoints disagree on %s result", method)
+		c.tracker.TrackQuorumDisagreement(c.domainID, method)
+	}
+
+	if best == nil || counts[best.key] < c.quorum {
+		agreed := 0
+		if best != nil {
+			agreed = counts[best.key]
+		}
+		return nil, fmt.Errorf("%w: %d of %d endpoints agreed on %s result, %d required", ErrNoQuorum, agreed, len(c.readers), method, c.quorum)
+	}
+	return best.value, nil
+}
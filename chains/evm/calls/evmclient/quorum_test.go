@@ -0,0 +1,126 @@
+package evmclient_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	mock_evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type QuorumClientTestSuite struct {
+	suite.Suite
+	quorumClient            *evmclient.QuorumClient
+	mockReaderA             *mock_evmclient.MockQuorumReader
+	mockReaderB             *mock_evmclient.MockQuorumReader
+	mockReaderC             *mock_evmclient.MockQuorumReader
+	mockDisagreementTracker *mock_evmclient.MockDisagreementTracker
+	bridge                  common.Address
+}
+
+func TestRunQuorumClientTestSuite(t *testing.T) {
+	suite.Run(t, new(QuorumClientTestSuite))
+}
+
+func (s *QuorumClientTestSuite) SetupSuite()    {}
+func (s *QuorumClientTestSuite) TearDownSuite() {}
+func (s *QuorumClientTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockReaderA = mock_evmclient.NewMockQuorumReader(gomockController)
+	s.mockReaderB = mock_evmclient.NewMockQuorumReader(gomockController)
+	s.mockReaderC = mock_evmclient.NewMockQuorumReader(gomockController)
+	s.mockDisagreementTracker = mock_evmclient.NewMockDisagreementTracker(gomockController)
+	s.quorumClient, _ = evmclient.NewQuorumClient(
+		nil,
+		[]evmclient.QuorumReader{s.mockReaderA, s.mockReaderB, s.mockReaderC},
+		2,
+		1,
+		s.mockDisagreementTracker,
+	)
+	s.bridge = common.HexToAddress("0x04005C8A516292af163b1AFe3D855b9f4f4631B5")
+}
+func (s *QuorumClientTestSuite) TearDownTest() {}
+
+func (s *QuorumClientTestSuite) TestNewQuorumClient_InvalidQuorum() {
+	_, err := evmclient.NewQuorumClient(nil, []evmclient.QuorumReader{s.mockReaderA}, 2, 1, s.mockDisagreementTracker)
+
+	s.NotNil(err)
+}
+
+func (s *QuorumClientTestSuite) TestCallContract_AllAgree() {
+	for _, r := range []*mock_evmclient.MockQuorumReader{s.mockReaderA, s.mockReaderB, s.mockReaderC} {
+		r.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(10)).Return([]byte{1}, nil)
+	}
+
+	res, err := s.quorumClient.CallContract(context.Background(), map[string]interface{}{}, big.NewInt(10))
+
+	s.Nil(err)
+	s.Equal([]byte{1}, res)
+}
+
+func (s *QuorumClientTestSuite) TestCallContract_QuorumReachedWithDisagreement() {
+	s.mockReaderA.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(10)).Return([]byte{1}, nil)
+	s.mockReaderB.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(10)).Return([]byte{2}, nil)
+	s.mockReaderC.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(10)).Return([]byte{1}, nil)
+	s.mockDisagreementTracker.EXPECT().TrackQuorumDisagreement(uint8(1), "eth_call")
+
+	res, err := s.quorumClient.CallContract(context.Background(), map[string]interface{}{}, big.NewInt(10))
+
+	s.Nil(err)
+	s.Equal([]byte{1}, res)
+}
+
+func (s *QuorumClientTestSuite) TestCallContract_NoQuorum() {
+	s.mockReaderA.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(10)).Return([]byte{1}, nil)
+	s.mockReaderB.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(10)).Return([]byte{2}, nil)
+	s.mockReaderC.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(10)).Return(nil, errors.New("error"))
+	s.mockDisagreementTracker.EXPECT().TrackQuorumDisagreement(uint8(1), "eth_call")
+
+	_, err := s.quorumClient.CallContract(context.Background(), map[string]interface{}{}, big.NewInt(10))
+
+	s.True(errors.Is(err, evmclient.ErrNoQuorum))
+}
+
+func (s *QuorumClientTestSuite) TestCallContract_LatestBlockPinnedToQuorumHead() {
+	s.mockReaderA.EXPECT().LatestBlock().Return(big.NewInt(12), nil)
+	s.mockReaderB.EXPECT().LatestBlock().Return(big.NewInt(10), nil)
+	s.mockReaderC.EXPECT().LatestBlock().Return(big.NewInt(11), nil)
+	for _, r := range []*mock_evmclient.MockQuorumReader{s.mockReaderA, s.mockReaderB, s.mockReaderC} {
+		r.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(11)).Return([]byte{1}, nil)
+	}
+
+	res, err := s.quorumClient.CallContract(context.Background(), map[string]interface{}{}, nil)
+
+	s.Nil(err)
+	s.Equal([]byte{1}, res)
+}
+
+func (s *QuorumClientTestSuite) TestFetchEventLogs_QuorumReached() {
+	logs := []types.Log{{Address: s.bridge, BlockNumber: 5, TxHash: common.Hash{1}}}
+	s.mockReaderA.EXPECT().FetchLogs(gomock.Any(), s.bridge, []string{"Deposit"}, big.NewInt(1), big.NewInt(5)).Return(logs, nil)
+	s.mockReaderB.EXPECT().FetchLogs(gomock.Any(), s.bridge, []string{"Deposit"}, big.NewInt(1), big.NewInt(5)).Return([]types.Log{}, nil)
+	s.mockReaderC.EXPECT().FetchLogs(gomock.Any(), s.bridge, []string{"Deposit"}, big.NewInt(1), big.NewInt(5)).Return(logs, nil)
+	s.mockDisagreementTracker.EXPECT().TrackQuorumDisagreement(uint8(1), "eth_getLogs")
+
+	res, err := s.quorumClient.FetchEventLogs(context.Background(), s.bridge, "Deposit", big.NewInt(1), big.NewInt(5))
+
+	s.Nil(err)
+	s.Equal(logs, res)
+}
+
+func (s *QuorumClientTestSuite) TestFetchEventLogs_NoQuorum() {
+	s.mockReaderA.EXPECT().FetchLogs(gomock.Any(), s.bridge, []string{"Deposit"}, big.NewInt(1), big.NewInt(5)).Return([]types.Log{{TxHash: common.Hash{1}}}, nil)
+	s.mockReaderB.EXPECT().FetchLogs(gomock.Any(), s.bridge, []string{"Deposit"}, big.NewInt(1), big.NewInt(5)).Return([]types.Log{{TxHash: common.Hash{2}}}, nil)
+	s.mockReaderC.EXPECT().FetchLogs(gomock.Any(), s.bridge, []string{"Deposit"}, big.NewInt(1), big.NewInt(5)).Return([]types.Log{}, nil)
+	s.mockDisagreementTracker.EXPECT().TrackQuorumDisagreement(uint8(1), "eth_getLogs")
+
+	_, err := s.quorumClient.FetchEventLogs(context.Background(), s.bridge, "Deposit", big.NewInt(1), big.NewInt(5))
+
+	s.True(errors.Is(err, evmclient.ErrNoQuorum))
+}
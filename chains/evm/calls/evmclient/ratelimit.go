@@ -0,0 +1,298 @@
+package evmclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+type RetryMetrics interface {
+	TrackRPCRetry(endpoint string)
+	TrackRPCThrottle(endpoint string)
+}
+
+// RateLimitOpts configures client-side rate limiting and retries of RPC requests
+type RateLimitOpts struct {
+	// RequestsPerSecond is the rate of requests sent to a single endpoint. If 0 - not limited
+	RequestsPerSecond float64
+	// Burst is the number of requests that can be sent at once before rate limit applies
+	Burst int
+	// MaxRetries is the number of times throttled or failed request is retried
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound exponential backoff between retries
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// Metrics tracks retried and throttled requests. If nil - not tracked
+	Metrics RetryMetrics
+}
+
+const (
+	// errCodeLimitExceeded is returned by providers both for throttled requests and for queries
+	// over their range or result limits
+	errCodeLimitExceeded   = -32005
+	errCodeTooManyRequests = 429
+)
+
+// queryLimitMessages are fragments of limit exceeded error messages of queries over provider
+// range or result limits, which fail the same way when retried
+var queryLimitMessages = []string{"range", "more than", "results", "response size"}
+
+var DefaultRateLimitOpts = RateLimitOpts{
+	MaxRetries: 5,
+	MinBackoff: 250 * time.Millisecond,
+	MaxBackoff: 10 * time.Second,
+}
+
+// tokenBucket allows rate requests per second with bursts of up to burst requests
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	lock   sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait takes a token from the bucket, waiting until it is available
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	b.lock.Lock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	wait := time.Duration(-b.tokens / b.rate * float64(time.Second))
+	b.lock.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RateLimitedTransport is a HTTP transport of the RPC client that limits the rate of requests
+// sent to the endpoint and retries requests that were throttled or failed with transient errors
+// with exponential backoff and jitter. Requests sending transactions are only retried when throttled,
+// as other failures can happen after the node already received the transaction.
+type RateLimitedTransport struct {
+	next     http.RoundTripper
+	endpoint string
+	opts     RateLimitOpts
+	bucket   *tokenBucket
+}
+
+func NewRateLimitedTransport(next http.RoundTripper, endpoint string, opts RateLimitOpts) *RateLimitedTransport {
+	t := &RateLimitedTransport{
+		next:     next,
+		endpoint: endpoint,
+		opts:     opts,
+	}
+	if opts.RequestsPerSecond > 0 {
+		t.bucket = newTokenBucket(opts.RequestsPerSecond, opts.Burst)
+	}
+	return t
+}
+
+func (t *RateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	sendsTx := bytes.Contains(body, []byte(`"eth_sendRawTransaction"`))
+
+	for attempt := 0; ; attempt++ {
+		if t.bucket != nil {
+			if err := t.bucket.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+		}
+
+		attemptReq := req.Clone(req.Context())
+		attemptReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+		resp, err := t.next.RoundTrip(attemptReq)
+		throttled := err == nil && (resp.StatusCode == http.StatusTooManyRequests || throttledResponse(resp))
+		if !t.retriable(req.Context(), resp, err, throttled, sendsTx) || attempt >= t.opts.MaxRetries {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt)
+		if resp != nil {
+			if throttled {
+				if t.opts.Metrics != nil {
+					t.opts.Metrics.TrackRPCThrottle(t.endpoint)
+				}
+				if retryAfter := parseRetryAfter(resp.Header.Get("Retry-After")); retryAfter > delay {
+					delay = retryAfter
+				}
+			}
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+			log.Debug().Str("endpoint", t.endpoint).Msgf("RPC request failed with status %d, retrying in %s", resp.StatusCode, delay)
+		} else {
+			log.Debug().Err(err).Str("endpoint", t.endpoint).Msgf("RPC request failed, retrying in %s", delay)
+		}
+		if t.opts.Metrics != nil {
+			t.opts.Metrics.TrackRPCRetry(t.endpoint)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// retriable checks if request was throttled or failed with transient error. Requests sending
+// transactions are retried only when throttled.
+func (t *RateLimitedTransport) retriable(ctx context.Context, resp *http.Response, err error, throttled bool, sendsTx bool) bool {
+	if throttled {
+		return true
+	}
+	if sendsTx {
+		return false
+	}
+	if err != nil {
+		return ctx.Err() == nil
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// throttledResponse checks if successful HTTP response carries JSON-RPC error of a throttled
+// request. Response body is buffered so it can still be read by the RPC client.
+func throttledResponse(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+	if err != nil || !bytes.Contains(data, []byte(`"error"`)) {
+		return false
+	}
+
+	var msgs []jsonrpcResponse
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		err = json.Unmarshal(data, &msgs)
+	} else {
+		msgs = make([]jsonrpcResponse, 1)
+		err = json.Unmarshal(data, &msgs[0])
+	}
+	if err != nil {
+		return false
+	}
+	for _, msg := range msgs {
+		if msg.Error != nil && isThrottleError(msg.Error.Code, msg.Error.Message) {
+			return true
+		}
+	}
+	return false
+}
+
+// isThrottleError checks if JSON-RPC error was returned for a request over provider rate limits
+func isThrottleError(code int, message string) bool {
+	switch code {
+	case errCodeTooManyRequests:
+		return true
+	case errCodeLimitExceeded:
+		return !isQueryLimitMessage(message)
+	default:
+		return false
+	}
+}
+
+// isQueryLimitMessage checks if limit exceeded error message is about the query range or result size
+func isQueryLimitMessage(message string) bool {
+	message = strings.ToLower(message)
+	for _, fragment := range queryLimitMessages {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns exponential backoff of the attempt with full jitter
+func (t *RateLimitedTransport) backoff(attempt int) time.Duration {
+	max := float64(t.opts.MinBackoff) * math.Pow(2, float64(attempt))
+	if t.opts.MaxBackoff > 0 && max > float64(t.opts.MaxBackoff) {
+		max = float64(t.opts.MaxBackoff)
+	}
+	if max < 1 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max)))
+}
+
+func parseRetryAfter(value string) time.Duration {
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return 0
+}
+
+// DialRPC creates RPC client of the endpoint. Requests to HTTP endpoints are sent through
+// RateLimitedTransport while other endpoints are dialed directly.
+func DialRPC(ctx context.Context, endpoint string, opts RateLimitOpts) (*rpc.Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return rpc.DialContext(ctx, endpoint)
+	}
+
+	// credentials are often part of the path of hosted endpoints so only host identifies endpoint
+	client := &http.Client{Transport: NewRateLimitedTransport(http.DefaultTransport, strings.ToLower(u.Host), opts)}
+	return rpc.DialHTTPWithClient(endpoint, client)
+}
+
+// NewRateLimitedEVMClient creates a client for EVMChain with provided signer that rate limits and
+// retries requests sent to the HTTP endpoint
+func NewRateLimitedEVMClient(endpoint string, signer Signer, opts RateLimitOpts) (*EVMClient, error) {
+	rpcClient, err := DialRPC(context.TODO(), endpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	return NewEVMClientFromRPC(rpcClient, signer), nil
+}
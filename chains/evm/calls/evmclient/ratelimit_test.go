@@ -0,0 +1,188 @@
+package evmclient_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	mock_evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/mock"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitedTransportTestSuite struct {
+	suite.Suite
+	mockRetryMetrics *mock_evmclient.MockRetryMetrics
+	opts             evmclient.RateLimitOpts
+	server           *httptest.Server
+	requests         int32
+	failures         int32
+	failureStatus    int
+	failureBody      string
+}
+
+func TestRunRateLimitedTransportTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitedTransportTestSuite))
+}
+
+func (s *RateLimitedTransportTestSuite) SetupSuite()    {}
+func (s *RateLimitedTransportTestSuite) TearDownSuite() {}
+func (s *RateLimitedTransportTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockRetryMetrics = mock_evmclient.NewMockRetryMetrics(gomockController)
+	s.opts = evmclient.RateLimitOpts{
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+		Metrics:    s.mockRetryMetrics,
+	}
+	s.requests = 0
+	s.failures = 0
+	s.failureStatus = http.StatusTooManyRequests
+	s.failureBody = ""
+
+	rpcServer := rpc.NewServer()
+	_ = rpcServer.RegisterName("eth", &testEthService{id: 1, head: 100})
+	// server fails first requests with failure status before serving them
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.requests, 1)
+		if atomic.AddInt32(&s.failures, -1) >= 0 {
+			w.WriteHeader(s.failureStatus)
+			_, _ = w.Write([]byte(s.failureBody))
+			return
+		}
+		rpcServer.ServeHTTP(w, r)
+	}))
+}
+func (s *RateLimitedTransportTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *RateLimitedTransportTestSuite) client() *evmclient.EVMClient {
+	client, err := evmclient.NewRateLimitedEVMClient(s.server.URL, nil, s.opts)
+	s.Nil(err)
+	return client
+}
+
+func (s *RateLimitedTransportTestSuite) TestThrottledRequestRetried() {
+	s.failures = 2
+	host := strings.TrimPrefix(s.server.URL, "http://")
+	s.mockRetryMetrics.EXPECT().TrackRPCThrottle(host).Times(2)
+	s.mockRetryMetrics.EXPECT().TrackRPCRetry(host).Times(2)
+
+	id, err := s.client().ChainID(context.Background())
+
+	s.Nil(err)
+	s.Equal(big.NewInt(1), id)
+	s.Equal(int32(3), atomic.LoadInt32(&s.requests))
+}
+
+func (s *RateLimitedTransportTestSuite) TestUnavailableEndpointRetried() {
+	s.failures = 1
+	s.failureStatus = http.StatusServiceUnavailable
+	s.mockRetryMetrics.EXPECT().TrackRPCRetry(gomock.Any()).Times(1)
+
+	id, err := s.client().ChainID(context.Background())
+
+	s.Nil(err)
+	s.Equal(big.NewInt(1), id)
+}
+
+func (s *RateLimitedTransportTestSuite) TestRetriesExhausted() {
+	s.failures = 10
+	s.mockRetryMetrics.EXPECT().TrackRPCThrottle(gomock.Any()).Times(3)
+	s.mockRetryMetrics.EXPECT().TrackRPCRetry(gomock.Any()).Times(3)
+
+	_, err := s.client().ChainID(context.Background())
+
+	s.NotNil(err)
+	s.Equal(int32(4), atomic.LoadInt32(&s.requests))
+}
+
+func (s *RateLimitedTransportTestSuite) TestClientErrorNotRetried() {
+	s.failures = 1
+	s.failureStatus = http.StatusBadRequest
+
+	_, err := s.client().ChainID(context.Background())
+
+	s.NotNil(err)
+	s.Equal(int32(1), atomic.LoadInt32(&s.requests))
+}
+
+func (s *RateLimitedTransportTestSuite) TestRequestsRateLimited() {
+	s.opts.RequestsPerSecond = 20
+	s.opts.Burst = 1
+	client := s.client()
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := client.ChainID(context.Background())
+		s.Nil(err)
+	}
+
+	s.GreaterOrEqual(time.Since(start), 180*time.Millisecond)
+}
+
+func (s *RateLimitedTransportTestSuite) TestThrottledJSONRPCErrorRetried() {
+	s.failures = 1
+	s.failureStatus = http.StatusOK
+	s.failureBody = `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"daily request count exceeded, request rate limited"}}`
+	s.mockRetryMetrics.EXPECT().TrackRPCThrottle(gomock.Any()).Times(1)
+	s.mockRetryMetrics.EXPECT().TrackRPCRetry(gomock.Any()).Times(1)
+
+	id, err := s.client().ChainID(context.Background())
+
+	s.Nil(err)
+	s.Equal(big.NewInt(1), id)
+	s.Equal(int32(2), atomic.LoadInt32(&s.requests))
+}
+
+func (s *RateLimitedTransportTestSuite) TestQueryLimitJSONRPCErrorNotRetried() {
+	s.failures = 1
+	s.failureStatus = http.StatusOK
+	s.failureBody = `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"block range too large"}}`
+
+	_, err := s.client().ChainID(context.Background())
+
+	s.NotNil(err)
+	s.Equal(int32(1), atomic.LoadInt32(&s.requests))
+}
+
+func (s *RateLimitedTransportTestSuite) TestTransactionNotResentOnUnavailableEndpoint() {
+	s.failures = 1
+	s.failureStatus = http.StatusServiceUnavailable
+
+	err := s.client().SendRawTransaction(context.Background(), []byte{1})
+
+	s.NotNil(err)
+	s.Equal(int32(1), atomic.LoadInt32(&s.requests))
+}
+
+func (s *RateLimitedTransportTestSuite) TestThrottledTransactionResent() {
+	s.failures = 1
+	s.mockRetryMetrics.EXPECT().TrackRPCThrottle(gomock.Any()).Times(1)
+	s.mockRetryMetrics.EXPECT().TrackRPCRetry(gomock.Any()).Times(1)
+
+	err := s.client().SendRawTransaction(context.Background(), []byte{1})
+
+	s.Nil(err)
+	s.Equal(int32(2), atomic.LoadInt32(&s.requests))
+}
+
+func (s *RateLimitedTransportTestSuite) TestAlreadyKnownTransactionSent() {
+	s.failures = 1
+	s.failureStatus = http.StatusOK
+	s.failureBody = `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"already known"}}`
+
+	err := s.client().SendRawTransaction(context.Background(), []byte{1})
+
+	s.Nil(err)
+	s.Equal(int32(1), atomic.LoadInt32(&s.requests))
+}
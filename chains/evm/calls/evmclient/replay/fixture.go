@@ -0,0 +1,55 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+)
+
+// Exchange is a recorded JSON-RPC request and the response endpoint returned to it
+type Exchange struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// Fixture is a sequence of JSON-RPC exchanges in the order they were recorded
+type Fixture struct {
+	Exchanges []Exchange `json:"exchanges"`
+}
+
+// LoadFixture reads fixture from the file
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &Fixture{}
+	err = json.Unmarshal(data, f)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Save writes fixture to the file
+func (f *Fixture) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// requestKey identifies requests by method and params regardless of their formatting
+func requestKey(method string, params json.RawMessage) string {
+	var p interface{}
+	decoder := json.NewDecoder(bytes.NewReader(params))
+	decoder.UseNumber()
+	if len(params) == 0 || decoder.Decode(&p) != nil {
+		return method + string(bytes.TrimSpace(params))
+	}
+	canonical, _ := json.Marshal(p)
+	return method + string(canonical)
+}
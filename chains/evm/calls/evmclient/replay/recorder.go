@@ -0,0 +1,144 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+type jsonrpcRequest struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type jsonrpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   json.RawMessage `json:"error,omitempty"`
+}
+
+// Recorder is a HTTP transport of the RPC client that records JSON-RPC exchanges
+// with the endpoint so that they can be saved to a fixture and replayed later
+type Recorder struct {
+	next http.RoundTripper
+
+	lock    sync.Mutex
+	fixture Fixture
+}
+
+func NewRecorder(next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{next: next}
+}
+
+// DialRecording creates RPC client of the HTTP endpoint whose exchanges are recorded by the returned recorder
+func DialRecording(endpoint string) (*rpc.Client, *Recorder, error) {
+	recorder := NewRecorder(http.DefaultTransport)
+	client, err := rpc.DialHTTPWithClient(endpoint, &http.Client{Transport: recorder})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, recorder, nil
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := r.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	if resp.StatusCode == http.StatusOK {
+		r.record(reqBody, respBody)
+	}
+	return resp, nil
+}
+
+// Fixture returns exchanges recorded so far
+func (r *Recorder) Fixture() *Fixture {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return &Fixture{Exchanges: append([]Exchange{}, r.fixture.Exchanges...)}
+}
+
+// Save writes exchanges recorded so far to the fixture file
+func (r *Recorder) Save(path string) error {
+	return r.Fixture().Save(path)
+}
+
+func (r *Recorder) record(reqBody, respBody []byte) {
+	var requests []jsonrpcRequest
+	var responses []jsonrpcResponse
+	if isBatch(reqBody) {
+		if err := json.Unmarshal(reqBody, &requests); err != nil {
+			log.Warn().Err(err).Msg("Failed recording JSON-RPC batch request")
+			return
+		}
+		if err := json.Unmarshal(respBody, &responses); err != nil {
+			log.Warn().Err(err).Msg("Failed recording JSON-RPC batch response")
+			return
+		}
+	} else {
+		requests = make([]jsonrpcRequest, 1)
+		responses = make([]jsonrpcResponse, 1)
+		if err := json.Unmarshal(reqBody, &requests[0]); err != nil {
+			log.Warn().Err(err).Msg("Failed recording JSON-RPC request")
+			return
+		}
+		if err := json.Unmarshal(respBody, &responses[0]); err != nil {
+			log.Warn().Err(err).Msg("Failed recording JSON-RPC response")
+			return
+		}
+	}
+
+	byID := make(map[string]jsonrpcResponse)
+	for _, res := range responses {
+		byID[string(res.ID)] = res
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, req := range requests {
+		res, ok := byID[string(req.ID)]
+		if !ok {
+			continue
+		}
+		r.fixture.Exchanges = append(r.fixture.Exchanges, Exchange{
+			Method: req.Method,
+			Params: req.Params,
+			Result: res.Result,
+			Error:  res.Error,
+		})
+	}
+}
+
+func isBatch(msg []byte) bool {
+	msg = bytes.TrimSpace(msg)
+	return len(msg) > 0 && msg[0] == '['
+}
@@ -0,0 +1,154 @@
+package replay_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/replay"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/suite"
+)
+
+type revertError struct{}
+
+func (e *revertError) Error() string          { return "execution reverted" }
+func (e *revertError) ErrorCode() int         { return 3 }
+func (e *revertError) ErrorData() interface{} { return "0x08c379a0" }
+
+// testEthService returns higher head on each call and reverts all calls
+type testEthService struct {
+	head uint64
+}
+
+func (s *testEthService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(5))
+}
+
+func (s *testEthService) BlockNumber() hexutil.Uint64 {
+	s.head++
+	return hexutil.Uint64(s.head)
+}
+
+func (s *testEthService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	return nil, &revertError{}
+}
+
+func ethereumCallMsg() ethereum.CallMsg {
+	to := common.HexToAddress("0x5f75ce92326e304962b22749bd71e36976171285")
+	return ethereum.CallMsg{To: &to, Data: []byte{1}}
+}
+
+type ReplayTestSuite struct {
+	suite.Suite
+	fixture *replay.Fixture
+}
+
+func TestRunReplayTestSuite(t *testing.T) {
+	suite.Run(t, new(ReplayTestSuite))
+}
+
+func (s *ReplayTestSuite) SetupSuite()    {}
+func (s *ReplayTestSuite) TearDownSuite() {}
+func (s *ReplayTestSuite) SetupTest() {
+	server := rpc.NewServer()
+	_ = server.RegisterName("eth", &testEthService{head: 10})
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	rpcClient, recorder, err := replay.DialRecording(httpServer.URL)
+	s.Nil(err)
+	client := ethclient.NewClient(rpcClient)
+	_, _ = client.ChainID(context.Background())
+	_, _ = client.BlockNumber(context.Background())
+	_, _ = client.BlockNumber(context.Background())
+	_, _ = client.CallContract(context.Background(), ethereumCallMsg(), nil)
+	s.fixture = recorder.Fixture()
+}
+func (s *ReplayTestSuite) TearDownTest() {}
+
+func (s *ReplayTestSuite) client(server *replay.Server) *ethclient.Client {
+	rpcClient, err := server.Dial(context.Background())
+	s.Nil(err)
+	return ethclient.NewClient(rpcClient)
+}
+
+func (s *ReplayTestSuite) TestRecord() {
+	s.Len(s.fixture.Exchanges, 4)
+	s.Equal("eth_chainId", s.fixture.Exchanges[0].Method)
+	s.Equal("eth_call", s.fixture.Exchanges[3].Method)
+	s.NotNil(s.fixture.Exchanges[3].Error)
+}
+
+func (s *ReplayTestSuite) TestReplay_RepeatedRequestsServedInOrder() {
+	client := s.client(replay.NewServer(s.fixture))
+
+	first, err := client.BlockNumber(context.Background())
+	s.Nil(err)
+	second, err := client.BlockNumber(context.Background())
+	s.Nil(err)
+	third, err := client.BlockNumber(context.Background())
+	s.Nil(err)
+
+	s.Equal(uint64(11), first)
+	s.Equal(uint64(12), second)
+	s.Equal(uint64(12), third)
+}
+
+func (s *ReplayTestSuite) TestReplay_ErrorReplayed() {
+	client := s.client(replay.NewServer(s.fixture))
+
+	_, err := client.CallContract(context.Background(), ethereumCallMsg(), nil)
+
+	var dataErr rpc.DataError
+	s.True(errors.As(err, &dataErr))
+	s.Equal("execution reverted", dataErr.Error())
+	s.Equal("0x08c379a0", dataErr.ErrorData())
+}
+
+func (s *ReplayTestSuite) TestReplay_NotRecordedRequest() {
+	client := s.client(replay.NewServer(s.fixture))
+
+	_, err := client.NetworkID(context.Background())
+
+	s.NotNil(err)
+}
+
+func (s *ReplayTestSuite) TestReplay_Batch() {
+	rpcClient, err := replay.NewServer(s.fixture).Dial(context.Background())
+	s.Nil(err)
+	var id hexutil.Big
+	var head hexutil.Uint64
+	err = rpcClient.BatchCallContext(context.Background(), []rpc.BatchElem{
+		{Method: "eth_chainId", Result: &id},
+		{Method: "eth_blockNumber", Result: &head},
+	})
+
+	s.Nil(err)
+	s.Equal(big.NewInt(5), id.ToInt())
+	s.Equal(hexutil.Uint64(11), head)
+}
+
+func (s *ReplayTestSuite) TestReplay_FromFileOverHTTP() {
+	path := filepath.Join(s.T().TempDir(), "fixture.json")
+	err := s.fixture.Save(path)
+	s.Nil(err)
+	server, err := replay.NewServerFromFile(path)
+	s.Nil(err)
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	client, err := ethclient.Dial(httpServer.URL)
+	s.Nil(err)
+
+	id, err := client.ChainID(context.Background())
+
+	s.Nil(err)
+	s.Equal(big.NewInt(5), id)
+}
@@ -0,0 +1,140 @@
+package replay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+const errCodeNotRecorded = -32601
+
+// Server replays recorded JSON-RPC exchanges. Requests with the same method and params are answered
+// with responses in the order they were recorded, repeating the last one once all of them were served.
+// Requests that were not recorded are answered with an error.
+type Server struct {
+	lock      sync.Mutex
+	responses map[string][]Exchange
+	served    map[string]int
+}
+
+func NewServer(fixture *Fixture) *Server {
+	s := &Server{
+		responses: make(map[string][]Exchange),
+		served:    make(map[string]int),
+	}
+	for _, e := range fixture.Exchanges {
+		key := requestKey(e.Method, e.Params)
+		s.responses[key] = append(s.responses[key], e)
+	}
+	return s
+}
+
+// NewServerFromFile creates a server replaying the fixture file
+func NewServerFromFile(path string) (*Server, error) {
+	fixture, err := LoadFixture(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewServer(fixture), nil
+}
+
+// Dial creates in-process RPC client served by the server
+func (s *Server) Dial(ctx context.Context) (*rpc.Client, error) {
+	requestsReader, requestsWriter := io.Pipe()
+	responsesReader, responsesWriter := io.Pipe()
+	go s.serve(requestsReader, responsesWriter)
+	return rpc.DialIO(ctx, responsesReader, requestsWriter)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	msg, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res, err := s.handle(msg)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+func (s *Server) serve(requests io.Reader, responses io.Writer) {
+	decoder := json.NewDecoder(requests)
+	encoder := json.NewEncoder(responses)
+	for {
+		var msg json.RawMessage
+		err := decoder.Decode(&msg)
+		if err != nil {
+			return
+		}
+		res, err := s.handle(msg)
+		if err != nil {
+			log.Error().Err(err).Msg("Invalid JSON-RPC request")
+			continue
+		}
+		if err := encoder.Encode(res); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) handle(msg []byte) (interface{}, error) {
+	if isBatch(msg) {
+		var batch []jsonrpcRequest
+		err := json.Unmarshal(msg, &batch)
+		if err != nil {
+			return nil, err
+		}
+		responses := make([]*jsonrpcResponse, len(batch))
+		for i, req := range batch {
+			responses[i] = s.respond(req)
+		}
+		return responses, nil
+	}
+
+	var req jsonrpcRequest
+	err := json.Unmarshal(msg, &req)
+	if err != nil {
+		return nil, err
+	}
+	return s.respond(req), nil
+}
+
+func (s *Server) respond(req jsonrpcRequest) *jsonrpcResponse {
+	key := requestKey(req.Method, req.Params)
+	s.lock.Lock()
+	recorded := s.responses[key]
+	i := s.served[key]
+	if i < len(recorded)-1 {
+		s.served[key]++
+	}
+	s.lock.Unlock()
+
+	res := &jsonrpcResponse{Version: "2.0", ID: req.ID}
+	if len(recorded) == 0 {
+		res.Error, _ = json.Marshal(map[string]interface{}{
+			"code":    errCodeNotRecorded,
+			"message": fmt.Sprintf("request %s %s was not recorded", req.Method, req.Params),
+		})
+		return res
+	}
+	if recorded[i].Error != nil {
+		res.Error = recorded[i].Error
+		return res
+	}
+	res.Result = recorded[i].Result
+	if res.Result == nil {
+		res.Result = json.RawMessage("null")
+	}
+	return res
+}
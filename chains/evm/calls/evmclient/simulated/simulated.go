@@ -0,0 +1,337 @@
+package simulated
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const DefaultGasLimit = 30000000
+
+// Chain is an in-process chain backed by go-ethereum simulated backend. Its clients are regular
+// EVMClients sending JSON-RPC requests to the chain, so they can be used wherever clients of a real
+// node are. Each transaction is mined in a new block as soon as it is sent.
+type Chain struct {
+	backend *backends.SimulatedBackend
+	server  *rpc.Server
+}
+
+// NewChain creates a chain with the genesis allocation
+func NewChain(alloc core.GenesisAlloc) (*Chain, error) {
+	backend := backends.NewSimulatedBackend(alloc, DefaultGasLimit)
+	server := rpc.NewServer()
+	err := server.RegisterName("eth", &ethService{backend: backend})
+	if err != nil {
+		return nil, err
+	}
+	err = server.RegisterName("net", &netService{backend: backend})
+	if err != nil {
+		return nil, err
+	}
+	return &Chain{
+		backend: backend,
+		server:  server,
+	}, nil
+}
+
+// Backend returns simulated backend of the chain
+func (c *Chain) Backend() *backends.SimulatedBackend {
+	return c.backend
+}
+
+// Server returns RPC server serving requests to the chain
+func (c *Chain) Server() *rpc.Server {
+	return c.server
+}
+
+// Client creates a client for the chain with provided signer
+func (c *Chain) Client(signer evmclient.Signer) *evmclient.EVMClient {
+	return evmclient.NewEVMClientFromRPC(rpc.DialInProc(c.server), signer)
+}
+
+// Mine mines empty blocks
+func (c *Chain) Mine(blocks int) {
+	for i := 0; i < blocks; i++ {
+		c.backend.Commit()
+	}
+}
+
+// MineEvery keeps mining a block in each interval until the context is cancelled
+func (c *Chain) MineEvery(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.backend.Commit()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Close stops the chain
+func (c *Chain) Close() error {
+	c.server.Stop()
+	return c.backend.Close()
+}
+
+type callArgs struct {
+	From     *common.Address `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+	Input    *hexutil.Bytes  `json:"input"`
+}
+
+func (args callArgs) toCallMsg() ethereum.CallMsg {
+	msg := ethereum.CallMsg{To: args.To}
+	if args.From != nil {
+		msg.From = *args.From
+	}
+	if args.Gas != nil {
+		msg.Gas = uint64(*args.Gas)
+	}
+	if args.GasPrice != nil {
+		msg.GasPrice = args.GasPrice.ToInt()
+	}
+	if args.Value != nil {
+		msg.Value = args.Value.ToInt()
+	}
+	if args.Input != nil {
+		msg.Data = *args.Input
+	} else if args.Data != nil {
+		msg.Data = *args.Data
+	}
+	return msg
+}
+
+// ethService serves eth namespace requests of EVMClient from the simulated backend
+type ethService struct {
+	backend  *backends.SimulatedBackend
+	sendLock sync.Mutex
+}
+
+func (s *ethService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(s.backend.Blockchain().Config().ChainID)
+}
+
+func (s *ethService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.backend.Blockchain().CurrentBlock().NumberU64())
+}
+
+func (s *ethService) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	block, err := s.backend.BlockByNumber(ctx, s.blockNumber(number))
+	if err != nil {
+		return nil, nil
+	}
+	return s.marshalBlock(block, fullTx)
+}
+
+func (s *ethService) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	block, err := s.backend.BlockByHash(ctx, hash)
+	if err != nil {
+		return nil, nil
+	}
+	return s.marshalBlock(block, fullTx)
+}
+
+func (s *ethService) GetBalance(ctx context.Context, address common.Address, number rpc.BlockNumber) (*hexutil.Big, error) {
+	balance, err := s.backend.BalanceAt(ctx, address, s.blockNumber(number))
+	return (*hexutil.Big)(balance), err
+}
+
+func (s *ethService) GetCode(ctx context.Context, address common.Address, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	if number == rpc.PendingBlockNumber {
+		return s.backend.PendingCodeAt(ctx, address)
+	}
+	return s.backend.CodeAt(ctx, address, s.blockNumber(number))
+}
+
+func (s *ethService) GetTransactionCount(ctx context.Context, address common.Address, number rpc.BlockNumber) (hexutil.Uint64, error) {
+	if number == rpc.PendingBlockNumber {
+		nonce, err := s.backend.PendingNonceAt(ctx, address)
+		return hexutil.Uint64(nonce), err
+	}
+	nonce, err := s.backend.NonceAt(ctx, address, s.blockNumber(number))
+	return hexutil.Uint64(nonce), err
+}
+
+func (s *ethService) Call(ctx context.Context, args callArgs, number rpc.BlockNumber) (hexutil.Bytes, error) {
+	if number == rpc.PendingBlockNumber {
+		return s.backend.PendingCallContract(ctx, args.toCallMsg())
+	}
+	return s.backend.CallContract(ctx, args.toCallMsg(), s.blockNumber(number))
+}
+
+func (s *ethService) EstimateGas(ctx context.Context, args callArgs) (hexutil.Uint64, error) {
+	gas, err := s.backend.EstimateGas(ctx, args.toCallMsg())
+	return hexutil.Uint64(gas), err
+}
+
+func (s *ethService) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := s.backend.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (s *ethService) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tip, err := s.backend.SuggestGasTipCap(ctx)
+	return (*hexutil.Big)(tip), err
+}
+
+// SendRawTransaction validates the transaction like a node would, as simulated backend panics
+// on invalid transactions, and mines it in a new block
+func (s *ethService) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (hash common.Hash, err error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(s.backend.Blockchain().Config().ChainID), tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	nonce, err := s.backend.PendingNonceAt(ctx, sender)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if tx.Nonce() < nonce {
+		return common.Hash{}, core.ErrNonceTooLow
+	}
+	if tx.Nonce() > nonce {
+		return common.Hash{}, core.ErrNonceTooHigh
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			s.backend.Rollback()
+			err = fmt.Errorf("invalid transaction: %v", r)
+		}
+	}()
+	err = s.backend.SendTransaction(ctx, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	s.backend.Commit()
+	return tx.Hash(), nil
+}
+
+func (s *ethService) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	return s.backend.TransactionReceipt(ctx, hash)
+}
+
+func (s *ethService) GetTransactionByHash(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
+	tx, isPending, err := s.backend.TransactionByHash(ctx, hash)
+	if err != nil {
+		return nil, nil
+	}
+	if isPending {
+		return s.marshalTransaction(tx, nil)
+	}
+	receipt, err := s.backend.TransactionReceipt(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return s.marshalTransaction(tx, receipt)
+}
+
+func (s *ethService) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]types.Log, error) {
+	logs, err := s.backend.FilterLogs(ctx, ethereum.FilterQuery(crit))
+	if err != nil {
+		return nil, err
+	}
+	if logs == nil {
+		logs = []types.Log{}
+	}
+	return logs, nil
+}
+
+// blockNumber converts block number of the request to block number of simulated backend
+func (s *ethService) blockNumber(number rpc.BlockNumber) *big.Int {
+	if number < 0 {
+		return nil
+	}
+	return big.NewInt(number.Int64())
+}
+
+func (s *ethService) marshalBlock(block *types.Block, fullTx bool) (map[string]interface{}, error) {
+	fields, err := toMap(block.Header())
+	if err != nil {
+		return nil, err
+	}
+	txs := make([]interface{}, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		if !fullTx {
+			txs[i] = tx.Hash()
+			continue
+		}
+		receipt, err := s.backend.TransactionReceipt(context.Background(), tx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		txs[i], err = s.marshalTransaction(tx, receipt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	fields["transactions"] = txs
+	fields["uncles"] = []common.Hash{}
+	fields["size"] = hexutil.Uint64(block.Size())
+	return fields, nil
+}
+
+func (s *ethService) marshalTransaction(tx *types.Transaction, receipt *types.Receipt) (map[string]interface{}, error) {
+	fields, err := toMap(tx)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(s.backend.Blockchain().Config().ChainID), tx)
+	if err != nil {
+		return nil, err
+	}
+	fields["from"] = sender
+	if receipt != nil {
+		fields["blockHash"] = receipt.BlockHash
+		fields["blockNumber"] = (*hexutil.Big)(receipt.BlockNumber)
+		fields["transactionIndex"] = hexutil.Uint64(receipt.TransactionIndex)
+	}
+	return fields, nil
+}
+
+// netService serves net namespace requests
+type netService struct {
+	backend *backends.SimulatedBackend
+}
+
+func (s *netService) Version() string {
+	return s.backend.Blockchain().Config().ChainID.String()
+}
+
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	err = json.Unmarshal(data, &fields)
+	return fields, err
+}
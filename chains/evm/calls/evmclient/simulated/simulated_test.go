@@ -0,0 +1,108 @@
+package simulated_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/erc20"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/simulated"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/local"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/suite"
+)
+
+type SimulatedChainTestSuite struct {
+	suite.Suite
+	chain *simulated.Chain
+}
+
+func TestRunSimulatedChainTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulatedChainTestSuite))
+}
+
+func (s *SimulatedChainTestSuite) SetupSuite()    {}
+func (s *SimulatedChainTestSuite) TearDownSuite() {}
+func (s *SimulatedChainTestSuite) SetupTest() {
+	var err error
+	s.chain, err = simulated.NewChain(core.GenesisAlloc{
+		local.EveKp.CommonAddress(): {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)},
+	})
+	s.Nil(err)
+}
+func (s *SimulatedChainTestSuite) TearDownTest() {
+	_ = s.chain.Close()
+}
+
+func (s *SimulatedChainTestSuite) TestLatestBlock() {
+	client := s.chain.Client(nil)
+	s.chain.Mine(2)
+
+	block, err := client.LatestBlock()
+
+	s.Nil(err)
+	s.Equal(big.NewInt(2), block)
+}
+
+func (s *SimulatedChainTestSuite) TestSetupBridge() {
+	client := s.chain.Client(local.EveKp)
+
+	conf, err := local.SetupEVMBridge(client, evmtransaction.NewTransaction, 1, big.NewInt(1), local.EveKp.CommonAddress(), local.DefaultRelayerAddresses)
+	s.Nil(err)
+
+	code, err := client.CodeAt(context.Background(), conf.BridgeAddr, nil)
+	s.Nil(err)
+	s.NotEmpty(code)
+	balance, err := erc20.NewERC20Contract(client, conf.Erc20Addr, nil).GetBalance(local.EveKp.CommonAddress())
+	s.Nil(err)
+	s.Equal(1, balance.Sign())
+}
+
+func (s *SimulatedChainTestSuite) TestFetchEventLogs() {
+	client := s.chain.Client(local.EveKp)
+	conf, err := local.SetupEVMBridge(client, evmtransaction.NewTransaction, 1, big.NewInt(1), local.EveKp.CommonAddress(), local.DefaultRelayerAddresses)
+	s.Nil(err)
+	head, err := client.LatestBlock()
+	s.Nil(err)
+
+	logs, err := client.FetchEventLogs(context.Background(), conf.BridgeAddr, string(events.ThresholdChangedSig), big.NewInt(0), head)
+
+	s.Nil(err)
+	s.Len(logs, 1)
+}
+
+func (s *SimulatedChainTestSuite) TestTransactionByHash() {
+	client := s.chain.Client(local.EveKp)
+	_, err := local.SetupEVMBridge(client, evmtransaction.NewTransaction, 1, big.NewInt(1), local.EveKp.CommonAddress(), local.DefaultRelayerAddresses)
+	s.Nil(err)
+	head, err := client.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+	block, err := client.BlockByNumber(context.Background(), head.Number)
+	s.Nil(err)
+	s.Len(block.Transactions(), 1)
+
+	tx, isPending, err := client.TransactionByHash(context.Background(), block.Transactions()[0].Hash())
+
+	s.Nil(err)
+	s.False(isPending)
+	s.Equal(block.Transactions()[0].Hash(), tx.Hash())
+}
+
+func (s *SimulatedChainTestSuite) TestSendRawTransaction_NonceTooLow() {
+	client := s.chain.Client(local.EveKp)
+	_, err := local.SetupEVMBridge(client, evmtransaction.NewTransaction, 1, big.NewInt(1), local.EveKp.CommonAddress(), local.DefaultRelayerAddresses)
+	s.Nil(err)
+	head, err := client.HeaderByNumber(context.Background(), nil)
+	s.Nil(err)
+	block, err := client.BlockByNumber(context.Background(), head.Number)
+	s.Nil(err)
+	raw, err := block.Transactions()[0].MarshalBinary()
+	s.Nil(err)
+
+	err = client.SendRawTransaction(context.Background(), raw)
+
+	s.NotNil(err)
+	s.Contains(err.Error(), core.ErrNonceTooLow.Error())
+}
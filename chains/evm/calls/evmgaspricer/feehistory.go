@@ -0,0 +1,131 @@
+package evmgaspricer
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sort"
+)
+
+// PriorityFeeParams determine fees of transactions sent with a priority
+type PriorityFeeParams struct {
+	// RewardPercentile is the percentile of priority fees paid in recent blocks used as the tip
+	RewardPercentile float64
+	// BaseFeeWindow is the number of consecutive full blocks after which transaction can still be mined
+	BaseFeeWindow int
+}
+
+type FeeHistoryOpts struct {
+	// BlockCount is the number of recent blocks priority fees are taken from
+	BlockCount uint64
+	// Priorities maps transaction priority to its fee params. Priorities not
+	// in the map use params of the DefaultPriority.
+	Priorities      map[uint8]PriorityFeeParams
+	DefaultPriority uint8
+}
+
+// DefaultFeeHistoryOpts maps transactor.TxPriorities to fee params. Transactions without
+// priority ("none") use params of the DefaultPriority.
+var DefaultFeeHistoryOpts = FeeHistoryOpts{
+	BlockCount: 10,
+	Priorities: map[uint8]PriorityFeeParams{
+		1: {RewardPercentile: 10, BaseFeeWindow: 2},
+		2: {RewardPercentile: 50, BaseFeeWindow: 4},
+		3: {RewardPercentile: 90, BaseFeeWindow: 6},
+	},
+	DefaultPriority: 2,
+}
+
+// FeeHistoryGasPriceDeterminant determines EIP-1559 fees from eth_feeHistory. Tip is the median
+// of priority fees paid at the priority reward percentile in recent blocks, and max fee covers
+// the tip and the base fee grown by the maximal 12.5% per block over the priority base fee window.
+type FeeHistoryGasPriceDeterminant struct {
+	client  FeeHistoryClient
+	opts    *GasPricerOpts
+	feeOpts FeeHistoryOpts
+}
+
+func NewFeeHistoryGasPriceDeterminant(client FeeHistoryClient, opts *GasPricerOpts, feeOpts FeeHistoryOpts) *FeeHistoryGasPriceDeterminant {
+	return &FeeHistoryGasPriceDeterminant{client: client, opts: opts, feeOpts: feeOpts}
+}
+
+func (gasPricer *FeeHistoryGasPriceDeterminant) SetClient(client FeeHistoryClient) {
+	gasPricer.client = client
+}
+func (gasPricer *FeeHistoryGasPriceDeterminant) SetOpts(opts *GasPricerOpts) {
+	gasPricer.opts = opts
+}
+
+func (gasPricer *FeeHistoryGasPriceDeterminant) GasPrice(priority *uint8) ([]*big.Int, error) {
+	params, err := gasPricer.priorityParams(priority)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := gasPricer.client.FeeHistory(context.TODO(), gasPricer.feeOpts.BlockCount, nil, []float64{params.RewardPercentile})
+	if err != nil {
+		return nil, err
+	}
+	// base fee is missing if eip1559 is not implemented or did not started working on the current chain
+	if len(history.BaseFee) == 0 || history.BaseFee[len(history.BaseFee)-1] == nil || history.BaseFee[len(history.BaseFee)-1].Sign() == 0 {
+		staticGasPricer := NewStaticGasPriceDeterminant(gasPricer.client, gasPricer.opts)
+		return staticGasPricer.GasPrice(nil)
+	}
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+
+	maxPriorityFeePerGas := medianReward(history.Reward)
+	maxFeePerGas := new(big.Int).Add(maxPriorityFeePerGas, grownBaseFee(nextBaseFee, params.BaseFeeWindow))
+
+	// Check we aren't exceeding our limit if gasPriceLimit set. Transaction is not
+	// mined until base fee drops under the limit if it is already higher.
+	if gasPricer.opts != nil && gasPricer.opts.UpperLimitFeePerGas != nil && maxFeePerGas.Cmp(gasPricer.opts.UpperLimitFeePerGas) == 1 {
+		maxFeePerGas = new(big.Int).Set(gasPricer.opts.UpperLimitFeePerGas)
+		tipLimit := new(big.Int).Sub(maxFeePerGas, nextBaseFee)
+		if tipLimit.Sign() < 0 {
+			tipLimit = big.NewInt(0)
+		}
+		if maxPriorityFeePerGas.Cmp(tipLimit) == 1 {
+			maxPriorityFeePerGas = tipLimit
+		}
+	}
+	return []*big.Int{maxPriorityFeePerGas, maxFeePerGas}, nil
+}
+
+func (gasPricer *FeeHistoryGasPriceDeterminant) priorityParams(priority *uint8) (PriorityFeeParams, error) {
+	if priority != nil {
+		params, ok := gasPricer.feeOpts.Priorities[*priority]
+		if ok {
+			return params, nil
+		}
+	}
+	params, ok := gasPricer.feeOpts.Priorities[gasPricer.feeOpts.DefaultPriority]
+	if !ok {
+		return PriorityFeeParams{}, errors.New("fee params of the default priority not configured")
+	}
+	return params, nil
+}
+
+// medianReward returns median of priority fees at the first requested percentile
+func medianReward(reward [][]*big.Int) *big.Int {
+	fees := make([]*big.Int, 0, len(reward))
+	for _, blockReward := range reward {
+		if len(blockReward) > 0 && blockReward[0] != nil {
+			fees = append(fees, blockReward[0])
+		}
+	}
+	if len(fees) == 0 {
+		return big.NewInt(0)
+	}
+
+	sort.Slice(fees, func(i, j int) bool { return fees[i].Cmp(fees[j]) < 0 })
+	return new(big.Int).Set(fees[len(fees)/2])
+}
+
+// grownBaseFee returns base fee after it grows by the maximal 12.5% in each of the blocks
+func grownBaseFee(baseFee *big.Int, blocks int) *big.Int {
+	fee := new(big.Int).Set(baseFee)
+	for i := 0; i < blocks; i++ {
+		fee.Add(fee, new(big.Int).Div(fee, big.NewInt(8)))
+	}
+	return fee
+}
@@ -0,0 +1,128 @@
+package evmgaspricer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	mock_evmgaspricer "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FeeHistoryGasPriceTestSuite struct {
+	suite.Suite
+	gasPricerMock *mock_evmgaspricer.MockFeeHistoryClient
+	history       *evmclient.FeeHistory
+}
+
+func TestRunFeeHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeHistoryGasPriceTestSuite))
+}
+
+func (s *FeeHistoryGasPriceTestSuite) SetupSuite()    {}
+func (s *FeeHistoryGasPriceTestSuite) TearDownSuite() {}
+func (s *FeeHistoryGasPriceTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.gasPricerMock = mock_evmgaspricer.NewMockFeeHistoryClient(gomockController)
+	s.history = &evmclient.FeeHistory{
+		Reward: [][]*big.Int{
+			{big.NewInt(1000000000)},
+			{big.NewInt(3000000000)},
+			{big.NewInt(2000000000)},
+		},
+		// next block base fee is 10 Gwei
+		BaseFee: []*big.Int{big.NewInt(9000000000), big.NewInt(9000000000), big.NewInt(9500000000), big.NewInt(10000000000)},
+	}
+}
+func (s *FeeHistoryGasPriceTestSuite) TearDownTest() {}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricer_FetchingFails() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, nil, DefaultFeeHistoryOpts)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(10), nil, []float64{50}).Return(nil, errors.New("error"))
+
+	_, err := gpd.GasPrice(nil)
+
+	s.NotNil(err)
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricer_SlowPriority() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, nil, DefaultFeeHistoryOpts)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(10), nil, []float64{10}).Return(s.history, nil)
+
+	priority := uint8(1)
+	res, err := gpd.GasPrice(&priority)
+
+	s.Nil(err)
+	s.Equal(len(res), 2)
+	s.Equal(0, res[0].Cmp(big.NewInt(2000000000)))  // median of rewards
+	s.Equal(0, res[1].Cmp(big.NewInt(14656250000))) // 2 Gwei + 10 Gwei grown over 2 blocks
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricer_FastPriority() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, nil, DefaultFeeHistoryOpts)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(10), nil, []float64{90}).Return(s.history, nil)
+
+	priority := uint8(3)
+	res, err := gpd.GasPrice(&priority)
+
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(2000000000)))
+	s.Equal(0, res[1].Cmp(big.NewInt(22272865294))) // 2 Gwei + 10 Gwei grown over 6 blocks
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricer_UnknownPriorityUsesDefault() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, nil, DefaultFeeHistoryOpts)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(10), nil, []float64{50}).Return(s.history, nil)
+
+	priority := uint8(200)
+	_, err := gpd.GasPrice(&priority)
+
+	s.Nil(err)
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricer_NoPriorityUsesDefault() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, nil, DefaultFeeHistoryOpts)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(10), nil, []float64{50}).Return(s.history, nil)
+
+	priority := uint8(0)
+	_, err := gpd.GasPrice(&priority)
+
+	s.Nil(err)
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricer_WithUpperLimit() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, &GasPricerOpts{UpperLimitFeePerGas: big.NewInt(11000000000)}, DefaultFeeHistoryOpts)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(10), nil, []float64{50}).Return(s.history, nil)
+
+	res, err := gpd.GasPrice(nil)
+
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(1000000000)))  // limited to UpperLimit - BaseFee
+	s.Equal(0, res[1].Cmp(big.NewInt(11000000000))) // equals to UpperLimit
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricer_UpperLimitLowerBaseFee() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, &GasPricerOpts{UpperLimitFeePerGas: big.NewInt(9000000000)}, DefaultFeeHistoryOpts)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(10), nil, []float64{50}).Return(s.history, nil)
+
+	res, err := gpd.GasPrice(nil)
+
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(0)))
+	s.Equal(0, res[1].Cmp(big.NewInt(9000000000)))
+}
+
+func (s *FeeHistoryGasPriceTestSuite) TestFeeHistoryGasPricer_NoBaseFee() {
+	gpd := NewFeeHistoryGasPriceDeterminant(s.gasPricerMock, nil, DefaultFeeHistoryOpts)
+	s.gasPricerMock.EXPECT().FeeHistory(gomock.Any(), uint64(10), nil, []float64{50}).Return(&evmclient.FeeHistory{}, nil)
+	s.gasPricerMock.EXPECT().SuggestGasPrice(gomock.Any()).Return(big.NewInt(5000000000), nil)
+
+	res, err := gpd.GasPrice(nil)
+
+	s.Nil(err)
+	s.Equal(len(res), 1)
+	s.Equal(0, res[0].Cmp(big.NewInt(5000000000)))
+}
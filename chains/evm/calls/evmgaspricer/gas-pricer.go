@@ -3,6 +3,8 @@ package evmgaspricer
 import (
 	"context"
 	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 )
 
 type LondonGasClient interface {
@@ -15,6 +17,17 @@ type GasPriceClient interface {
 	SuggestGasPrice(ctx context.Context) (*big.Int, error)
 }
 
+type FeeHistoryClient interface {
+	GasPriceClient
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*evmclient.FeeHistory, error)
+}
+
+// RegistryClient is the client gas pricers of any registered type can be created with
+type RegistryClient interface {
+	LondonGasClient
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*evmclient.FeeHistory, error)
+}
+
 // GasPricerOpts is the structure that holds parameters that could be used to configure different gasPRicer implementation
 type GasPricerOpts struct {
 	UpperLimitFeePerGas *big.Int      // UpperLimitFeePerGas in Static and London gasPricer limits the maximum gas price that could be used. In London gasPricer if BaseFee > UpperLimitFeePerGas, then maxFeeCap will be BaseFee + 2.5 Gwei for MaxTipCap. If nil - not applied
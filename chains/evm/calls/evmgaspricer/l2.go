@@ -0,0 +1,103 @@
+package evmgaspricer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// OptimismGasPriceOracleAddress is the address of the Optimism GasPriceOracle predeploy
+var OptimismGasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+
+const optimismGasPriceOracleABI = `[{"inputs":[{"internalType":"bytes","name":"_data","type":"bytes"}],"name":"getL1Fee","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+type GasPricer interface {
+	GasPrice(priority *uint8) ([]*big.Int, error)
+}
+
+// L1FeeOracle returns fee paid on rollup for posting the RLP-serialized signed transaction to L1
+type L1FeeOracle interface {
+	L1Fee(rawTx []byte) (*big.Int, error)
+}
+
+// ExecutionCost returns the highest cost of executing transaction with the gas limit
+// and gas prices, where the last gas price is either the legacy gas price or the fee cap
+func ExecutionCost(gasLimit uint64, gasPrices []*big.Int) *big.Int {
+	if len(gasPrices) == 0 {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasLimit), gasPrices[len(gasPrices)-1])
+}
+
+// ExecutionCostEstimator estimates cost of transactions on chains without L1 data fees. It is
+// also used on Arbitrum, where gas estimates and gas used already include the L1 data cost.
+type ExecutionCostEstimator struct{}
+
+func (e *ExecutionCostEstimator) EstimateCost(gasLimit uint64, gasPrices []*big.Int, rawTx []byte) (*big.Int, error) {
+	return ExecutionCost(gasLimit, gasPrices), nil
+}
+
+// L2GasPriceDeterminant prices rollup transactions. Gas prices are determined by the L2
+// gas pricer while transaction cost also includes L1 data fee of the signed transaction.
+type L2GasPriceDeterminant struct {
+	gasPricer GasPricer
+	oracle    L1FeeOracle
+}
+
+func NewL2GasPriceDeterminant(gasPricer GasPricer, oracle L1FeeOracle) *L2GasPriceDeterminant {
+	return &L2GasPriceDeterminant{gasPricer: gasPricer, oracle: oracle}
+}
+
+func (gasPricer *L2GasPriceDeterminant) GasPrice(priority *uint8) ([]*big.Int, error) {
+	return gasPricer.gasPricer.GasPrice(priority)
+}
+
+// EstimateCost returns the highest total cost of the transaction including its L1 data fee
+func (gasPricer *L2GasPriceDeterminant) EstimateCost(gasLimit uint64, gasPrices []*big.Int, rawTx []byte) (*big.Int, error) {
+	l1Fee, err := gasPricer.oracle.L1Fee(rawTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching L1 fee: %w", err)
+	}
+	return new(big.Int).Add(ExecutionCost(gasLimit, gasPrices), l1Fee), nil
+}
+
+// OptimismL1FeeOracle takes L1 fee of the RLP-serialized transaction from the Optimism
+// GasPriceOracle predeploy
+type OptimismL1FeeOracle struct {
+	client calls.ContractCaller
+	abi    abi.ABI
+}
+
+func NewOptimismL1FeeOracle(client calls.ContractCaller) *OptimismL1FeeOracle {
+	a, _ := abi.JSON(strings.NewReader(optimismGasPriceOracleABI))
+	return &OptimismL1FeeOracle{client: client, abi: a}
+}
+
+func (o *OptimismL1FeeOracle) L1Fee(rawTx []byte) (*big.Int, error) {
+	res, err := callOracle(o.client, o.abi, OptimismGasPriceOracleAddress, "getL1Fee", rawTx)
+	if err != nil {
+		return nil, err
+	}
+	return res[0].(*big.Int), nil
+}
+
+func callOracle(client calls.ContractCaller, a abi.ABI, address common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	input, err := a.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := client.CallContract(context.TODO(), calls.ToCallArg(ethereum.CallMsg{
+		To:   &address,
+		Data: input,
+	}), nil)
+	if err != nil {
+		return nil, err
+	}
+	return a.Unpack(method, out)
+}
@@ -0,0 +1,81 @@
+package evmgaspricer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type L2GasPriceTestSuite struct {
+	suite.Suite
+	contractCallerMock *mock_calls.MockContractCaller
+	gasPricerMock      *mock_calls.MockGasPricer
+}
+
+func TestRunL2GasPriceTestSuite(t *testing.T) {
+	suite.Run(t, new(L2GasPriceTestSuite))
+}
+
+func (s *L2GasPriceTestSuite) SetupSuite()    {}
+func (s *L2GasPriceTestSuite) TearDownSuite() {}
+func (s *L2GasPriceTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.contractCallerMock = mock_calls.NewMockContractCaller(gomockController)
+	s.gasPricerMock = mock_calls.NewMockGasPricer(gomockController)
+}
+func (s *L2GasPriceTestSuite) TearDownTest() {}
+
+func (s *L2GasPriceTestSuite) TestOptimismL1Fee() {
+	oracle := NewOptimismL1FeeOracle(s.contractCallerMock)
+	out, _ := oracle.abi.Methods["getL1Fee"].Outputs.Pack(big.NewInt(5000))
+	input, _ := oracle.abi.Pack("getL1Fee", []byte{1, 2, 3})
+	s.contractCallerMock.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).DoAndReturn(
+		func(ctx interface{}, callArgs map[string]interface{}, blockNumber *big.Int) ([]byte, error) {
+			s.Equal(&OptimismGasPriceOracleAddress, callArgs["to"])
+			s.Equal(hexutil.Bytes(input), callArgs["data"])
+			return out, nil
+		})
+
+	fee, err := oracle.L1Fee([]byte{1, 2, 3})
+
+	s.Nil(err)
+	s.Equal(big.NewInt(5000), fee)
+}
+
+func (s *L2GasPriceTestSuite) TestEstimateCost_IncludesL1Fee() {
+	oracle := NewOptimismL1FeeOracle(s.contractCallerMock)
+	out, _ := oracle.abi.Methods["getL1Fee"].Outputs.Pack(big.NewInt(5000))
+	s.contractCallerMock.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(out, nil)
+	gpd := NewL2GasPriceDeterminant(s.gasPricerMock, oracle)
+
+	cost, err := gpd.EstimateCost(100, []*big.Int{big.NewInt(1), big.NewInt(20)}, []byte{1})
+
+	s.Nil(err)
+	s.Equal(big.NewInt(7000), cost) // 100 gas * 20 fee cap + 5000 L1 fee
+}
+
+func (s *L2GasPriceTestSuite) TestEstimateCost_OracleFails() {
+	s.contractCallerMock.EXPECT().CallContract(gomock.Any(), gomock.Any(), nil).Return(nil, errors.New("error"))
+	gpd := NewL2GasPriceDeterminant(s.gasPricerMock, NewOptimismL1FeeOracle(s.contractCallerMock))
+
+	_, err := gpd.EstimateCost(100, []*big.Int{big.NewInt(20)}, []byte{1})
+
+	s.NotNil(err)
+}
+
+func (s *L2GasPriceTestSuite) TestGasPrice_FromL2GasPricer() {
+	priority := uint8(3)
+	s.gasPricerMock.EXPECT().GasPrice(&priority).Return([]*big.Int{big.NewInt(20)}, nil)
+	gpd := NewL2GasPriceDeterminant(s.gasPricerMock, NewOptimismL1FeeOracle(s.contractCallerMock))
+
+	res, err := gpd.GasPrice(&priority)
+
+	s.Nil(err)
+	s.Equal([]*big.Int{big.NewInt(20)}, res)
+}
@@ -9,6 +9,7 @@ import (
 	big "math/big"
 	reflect "reflect"
 
+	evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -117,3 +118,139 @@ func (mr *MockGasPriceClientMockRecorder) SuggestGasPrice(ctx interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasPrice", reflect.TypeOf((*MockGasPriceClient)(nil).SuggestGasPrice), ctx)
 }
+
+// MockFeeHistoryClient is a mock of FeeHistoryClient interface.
+type MockFeeHistoryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeHistoryClientMockRecorder
+}
+
+// MockFeeHistoryClientMockRecorder is the mock recorder for MockFeeHistoryClient.
+type MockFeeHistoryClientMockRecorder struct {
+	mock *MockFeeHistoryClient
+}
+
+// NewMockFeeHistoryClient creates a new mock instance.
+func NewMockFeeHistoryClient(ctrl *gomock.Controller) *MockFeeHistoryClient {
+	mock := &MockFeeHistoryClient{ctrl: ctrl}
+	mock.recorder = &MockFeeHistoryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeHistoryClient) EXPECT() *MockFeeHistoryClientMockRecorder {
+	return m.recorder
+}
+
+// FeeHistory mocks base method.
+func (m *MockFeeHistoryClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*evmclient.FeeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, blockCount, lastBlock, rewardPercentiles)
+	ret0, _ := ret[0].(*evmclient.FeeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeeHistory indicates an expected call of FeeHistory.
+func (mr *MockFeeHistoryClientMockRecorder) FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockFeeHistoryClient)(nil).FeeHistory), ctx, blockCount, lastBlock, rewardPercentiles)
+}
+
+// SuggestGasPrice mocks base method.
+func (m *MockFeeHistoryClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestGasPrice", ctx)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestGasPrice indicates an expected call of SuggestGasPrice.
+func (mr *MockFeeHistoryClientMockRecorder) SuggestGasPrice(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasPrice", reflect.TypeOf((*MockFeeHistoryClient)(nil).SuggestGasPrice), ctx)
+}
+
+// MockRegistryClient is a mock of RegistryClient interface.
+type MockRegistryClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryClientMockRecorder
+}
+
+// MockRegistryClientMockRecorder is the mock recorder for MockRegistryClient.
+type MockRegistryClientMockRecorder struct {
+	mock *MockRegistryClient
+}
+
+// NewMockRegistryClient creates a new mock instance.
+func NewMockRegistryClient(ctrl *gomock.Controller) *MockRegistryClient {
+	mock := &MockRegistryClient{ctrl: ctrl}
+	mock.recorder = &MockRegistryClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistryClient) EXPECT() *MockRegistryClientMockRecorder {
+	return m.recorder
+}
+
+// BaseFee mocks base method.
+func (m *MockRegistryClient) BaseFee() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseFee")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BaseFee indicates an expected call of BaseFee.
+func (mr *MockRegistryClientMockRecorder) BaseFee() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseFee", reflect.TypeOf((*MockRegistryClient)(nil).BaseFee))
+}
+
+// FeeHistory mocks base method.
+func (m *MockRegistryClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*evmclient.FeeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, blockCount, lastBlock, rewardPercentiles)
+	ret0, _ := ret[0].(*evmclient.FeeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeeHistory indicates an expected call of FeeHistory.
+func (mr *MockRegistryClientMockRecorder) FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockRegistryClient)(nil).FeeHistory), ctx, blockCount, lastBlock, rewardPercentiles)
+}
+
+// SuggestGasPrice mocks base method.
+func (m *MockRegistryClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestGasPrice", ctx)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestGasPrice indicates an expected call of SuggestGasPrice.
+func (mr *MockRegistryClientMockRecorder) SuggestGasPrice(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasPrice", reflect.TypeOf((*MockRegistryClient)(nil).SuggestGasPrice), ctx)
+}
+
+// SuggestGasTipCap mocks base method.
+func (m *MockRegistryClient) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestGasTipCap", ctx)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestGasTipCap indicates an expected call of SuggestGasTipCap.
+func (mr *MockRegistryClientMockRecorder) SuggestGasTipCap(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestGasTipCap", reflect.TypeOf((*MockRegistryClient)(nil).SuggestGasTipCap), ctx)
+}
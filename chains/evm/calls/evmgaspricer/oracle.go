@@ -0,0 +1,268 @@
+package evmgaspricer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxOracleResponseSize limits size of the oracle response read
+	maxOracleResponseSize = 1 << 20
+	// maxOracleBackoff limits the interval failing oracle is polled with
+	maxOracleBackoff = 10 * time.Minute
+)
+
+type OracleOpts struct {
+	URL string
+	// PricePaths maps transaction priority to the dot separated JSON path of its gas price
+	// in the oracle response, e.g. "result.FastGasPrice" or "fast.maxFee". Array elements are
+	// selected with numeric path segments. Priorities not in the map use the DefaultPriority path.
+	PricePaths      map[uint8]string
+	DefaultPriority uint8
+	// Unit is the number of wei in the oracle price unit, e.g. 1e9 for prices in Gwei
+	Unit *big.Int
+	// PollInterval is the interval the oracle is polled with after Start
+	PollInterval time.Duration
+	// StaleAfter is the age after which fetched prices are not used anymore
+	StaleAfter time.Duration
+	Timeout    time.Duration
+}
+
+// GasOracleDeterminant takes gas prices from HTTP gas station API. Prices are cached and refreshed
+// by the poller started with Start. StaticGasPriceDeterminant is used while cached prices are stale.
+type GasOracleDeterminant struct {
+	client     GasPriceClient
+	opts       *GasPricerOpts
+	oracleOpts OracleOpts
+	httpClient *http.Client
+
+	lock      sync.Mutex
+	prices    map[uint8]*big.Int
+	fetchedAt time.Time
+}
+
+func NewGasOracleDeterminant(client GasPriceClient, opts *GasPricerOpts, oracleOpts OracleOpts) *GasOracleDeterminant {
+	if oracleOpts.Unit == nil {
+		oracleOpts.Unit = big.NewInt(1)
+	}
+	return &GasOracleDeterminant{
+		client:     client,
+		opts:       opts,
+		oracleOpts: oracleOpts,
+		httpClient: &http.Client{Timeout: oracleOpts.Timeout},
+		prices:     make(map[uint8]*big.Int),
+	}
+}
+
+func (gasPricer *GasOracleDeterminant) SetClient(client GasPriceClient) {
+	gasPricer.client = client
+}
+func (gasPricer *GasOracleDeterminant) SetOpts(opts *GasPricerOpts) {
+	gasPricer.opts = opts
+}
+
+// Start polls the oracle in the background until the context is cancelled. Failing oracle is
+// polled with exponential backoff.
+func (gasPricer *GasOracleDeterminant) Start(ctx context.Context) {
+	go func() {
+		delay := gasPricer.oracleOpts.PollInterval
+		for {
+			err := gasPricer.Refresh(ctx)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Failed fetching gas prices from oracle %s", gasPricer.oracleOpts.URL)
+			}
+			delay = gasPricer.nextPollDelay(delay, err != nil)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+		}
+	}()
+}
+
+// nextPollDelay doubles the delay after failed poll, up to maxOracleBackoff, and resets it to
+// the poll interval after successful one
+func (gasPricer *GasOracleDeterminant) nextPollDelay(delay time.Duration, failed bool) time.Duration {
+	interval := gasPricer.oracleOpts.PollInterval
+	if !failed {
+		return interval
+	}
+	delay *= 2
+	if delay > maxOracleBackoff {
+		delay = maxOracleBackoff
+	}
+	if delay < interval {
+		delay = interval
+	}
+	return delay
+}
+
+// GasPrice returns cached oracle price of the priority, or static gas price if cached prices are stale.
+// It never fetches prices from the oracle.
+func (gasPricer *GasOracleDeterminant) GasPrice(priority *uint8) ([]*big.Int, error) {
+	prices, err := gasPricer.freshPrices()
+	var gp *big.Int
+	if err == nil {
+		gp, err = gasPricer.priorityPrice(prices, priority)
+	}
+	if err != nil {
+		log.Warn().Err(err).Msgf("Gas oracle %s unavailable, using static gas pricer", gasPricer.oracleOpts.URL)
+		return NewStaticGasPriceDeterminant(gasPricer.client, gasPricer.opts).GasPrice(priority)
+	}
+
+	if gasPricer.opts != nil {
+		if gasPricer.opts.GasPriceFactor != nil {
+			gp = multiplyGasPrice(gp, gasPricer.opts.GasPriceFactor)
+		}
+		if gasPricer.opts.UpperLimitFeePerGas != nil && gp.Cmp(gasPricer.opts.UpperLimitFeePerGas) == 1 {
+			gp = gasPricer.opts.UpperLimitFeePerGas
+		}
+	}
+	return []*big.Int{gp}, nil
+}
+
+// Refresh fetches gas prices of all priorities from the oracle
+func (gasPricer *GasOracleDeterminant) Refresh(ctx context.Context) error {
+	_, err := gasPricer.fetch(ctx)
+	return err
+}
+
+// freshPrices returns cached prices if they are not stale
+func (gasPricer *GasOracleDeterminant) freshPrices() (map[uint8]*big.Int, error) {
+	gasPricer.lock.Lock()
+	prices, fetchedAt := gasPricer.prices, gasPricer.fetchedAt
+	gasPricer.lock.Unlock()
+
+	if fetchedAt.IsZero() {
+		return nil, errors.New("prices not fetched yet")
+	}
+	if time.Since(fetchedAt) > gasPricer.oracleOpts.StaleAfter {
+		return nil, fmt.Errorf("prices fetched at %s are stale", fetchedAt)
+	}
+	return prices, nil
+}
+
+func (gasPricer *GasOracleDeterminant) fetch(ctx context.Context) (map[uint8]*big.Int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gasPricer.oracleOpts.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := gasPricer.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oracle responded with status %d", resp.StatusCode)
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxOracleResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var data interface{}
+	err = decoder.Decode(&data)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make(map[uint8]*big.Int, len(gasPricer.oracleOpts.PricePaths))
+	for priority, path := range gasPricer.oracleOpts.PricePaths {
+		value, err := lookupPath(data, path)
+		if err != nil {
+			return nil, err
+		}
+		price, err := parsePrice(value, gasPricer.oracleOpts.Unit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gas price at %s: %w", path, err)
+		}
+		prices[priority] = price
+	}
+
+	gasPricer.lock.Lock()
+	defer gasPricer.lock.Unlock()
+	gasPricer.prices = prices
+	gasPricer.fetchedAt = time.Now()
+	return prices, nil
+}
+
+// priorityPrice returns price of the priority, or of the default priority if it is not configured
+func (gasPricer *GasOracleDeterminant) priorityPrice(prices map[uint8]*big.Int, priority *uint8) (*big.Int, error) {
+	if priority != nil {
+		if price, ok := prices[*priority]; ok {
+			return new(big.Int).Set(price), nil
+		}
+	}
+	price, ok := prices[gasPricer.oracleOpts.DefaultPriority]
+	if !ok {
+		return nil, errors.New("oracle price path of the default priority not configured")
+	}
+	return new(big.Int).Set(price), nil
+}
+
+func lookupPath(data interface{}, path string) (interface{}, error) {
+	for _, key := range strings.Split(path, ".") {
+		switch node := data.(type) {
+		case map[string]interface{}:
+			value, ok := node[key]
+			if !ok {
+				return nil, fmt.Errorf("key %s of path %s not found", key, path)
+			}
+			data = value
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, fmt.Errorf("index %s of path %s not found", key, path)
+			}
+			data = node[i]
+		default:
+			return nil, fmt.Errorf("path %s not found", path)
+		}
+	}
+	return data, nil
+}
+
+// parsePrice converts decimal or hex oracle price in the unit into wei
+func parsePrice(value interface{}, unit *big.Int) (*big.Int, error) {
+	var s string
+	switch v := value.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return nil, fmt.Errorf("unexpected value %v", value)
+	}
+
+	if strings.HasPrefix(s, "0x") {
+		price, ok := new(big.Int).SetString(s[2:], 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid hex number %s", s)
+		}
+		return price.Mul(price, unit), nil
+	}
+
+	f, ok := new(big.Float).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %s", s)
+	}
+	price, _ := f.Mul(f, new(big.Float).SetInt(unit)).Int(nil)
+	return price, nil
+}
@@ -0,0 +1,178 @@
+package evmgaspricer
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mock_evmgaspricer "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GasOracleTestSuite struct {
+	suite.Suite
+	gasPricerMock *mock_evmgaspricer.MockGasPriceClient
+	server        *httptest.Server
+	response      string
+	status        int
+	requests      int
+	oracleOpts    OracleOpts
+}
+
+func TestRunGasOracleTestSuite(t *testing.T) {
+	suite.Run(t, new(GasOracleTestSuite))
+}
+
+func (s *GasOracleTestSuite) SetupSuite()    {}
+func (s *GasOracleTestSuite) TearDownSuite() {}
+func (s *GasOracleTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.gasPricerMock = mock_evmgaspricer.NewMockGasPriceClient(gomockController)
+	s.response = `{"status":"1","result":{"SafeGasPrice":"5","ProposeGasPrice":"6.5","FastGasPrice":7},"list":["0x3b9aca00"]}`
+	s.status = http.StatusOK
+	s.requests = 0
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests++
+		w.WriteHeader(s.status)
+		_, _ = w.Write([]byte(s.response))
+	}))
+	s.oracleOpts = OracleOpts{
+		URL: s.server.URL,
+		PricePaths: map[uint8]string{
+			1: "result.SafeGasPrice",
+			2: "result.ProposeGasPrice",
+			3: "result.FastGasPrice",
+		},
+		DefaultPriority: 2,
+		Unit:            big.NewInt(1000000000),
+		PollInterval:    time.Minute,
+		StaleAfter:      time.Minute,
+	}
+}
+func (s *GasOracleTestSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *GasOracleTestSuite) TestGasPrice_PriorityPrices() {
+	gpd := NewGasOracleDeterminant(s.gasPricerMock, nil, s.oracleOpts)
+	s.Nil(gpd.Refresh(context.Background()))
+
+	slow := uint8(1)
+	res, err := gpd.GasPrice(&slow)
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(5000000000)))
+
+	fast := uint8(3)
+	res, err = gpd.GasPrice(&fast)
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(7000000000)))
+
+	res, err = gpd.GasPrice(nil)
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(6500000000)))
+
+	// prices are cached
+	s.Equal(1, s.requests)
+}
+
+func (s *GasOracleTestSuite) TestGasPrice_OracleNotFetched() {
+	gpd := NewGasOracleDeterminant(s.gasPricerMock, nil, s.oracleOpts)
+	s.gasPricerMock.EXPECT().SuggestGasPrice(gomock.Any()).Return(big.NewInt(20000000000), nil)
+
+	res, err := gpd.GasPrice(nil)
+
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(20000000000)))
+	s.Equal(0, s.requests)
+}
+
+func (s *GasOracleTestSuite) TestGasPrice_HexPriceInArray() {
+	s.oracleOpts.PricePaths = map[uint8]string{0: "list.0"}
+	s.oracleOpts.DefaultPriority = 0
+	s.oracleOpts.Unit = nil
+	gpd := NewGasOracleDeterminant(s.gasPricerMock, nil, s.oracleOpts)
+	s.Nil(gpd.Refresh(context.Background()))
+
+	res, err := gpd.GasPrice(nil)
+
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(1000000000)))
+}
+
+func (s *GasOracleTestSuite) TestGasPrice_UpperLimit() {
+	gpd := NewGasOracleDeterminant(s.gasPricerMock, &GasPricerOpts{UpperLimitFeePerGas: big.NewInt(6000000000)}, s.oracleOpts)
+	s.Nil(gpd.Refresh(context.Background()))
+
+	fast := uint8(3)
+	res, err := gpd.GasPrice(&fast)
+
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(6000000000)))
+}
+
+func (s *GasOracleTestSuite) TestGasPrice_OracleDownFallsBackToStatic() {
+	s.status = http.StatusInternalServerError
+	gpd := NewGasOracleDeterminant(s.gasPricerMock, nil, s.oracleOpts)
+	s.NotNil(gpd.Refresh(context.Background()))
+	s.gasPricerMock.EXPECT().SuggestGasPrice(gomock.Any()).Return(big.NewInt(20000000000), nil)
+
+	res, err := gpd.GasPrice(nil)
+
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(20000000000)))
+}
+
+func (s *GasOracleTestSuite) TestGasPrice_MissingPathFallsBackToStatic() {
+	s.oracleOpts.PricePaths = map[uint8]string{2: "result.Missing"}
+	gpd := NewGasOracleDeterminant(s.gasPricerMock, nil, s.oracleOpts)
+	s.NotNil(gpd.Refresh(context.Background()))
+	s.gasPricerMock.EXPECT().SuggestGasPrice(gomock.Any()).Return(big.NewInt(20000000000), nil)
+
+	res, err := gpd.GasPrice(nil)
+
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(20000000000)))
+}
+
+func (s *GasOracleTestSuite) TestRefresh_StalePricesNotUsed() {
+	gpd := NewGasOracleDeterminant(s.gasPricerMock, nil, s.oracleOpts)
+	err := gpd.Refresh(context.Background())
+	s.Nil(err)
+
+	s.status = http.StatusInternalServerError
+	gpd.oracleOpts.StaleAfter = 0
+	s.gasPricerMock.EXPECT().SuggestGasPrice(gomock.Any()).Return(big.NewInt(20000000000), nil)
+
+	res, err := gpd.GasPrice(nil)
+
+	s.Nil(err)
+	s.Equal(0, res[0].Cmp(big.NewInt(20000000000)))
+}
+
+func (s *GasOracleTestSuite) TestRefresh_OversizedResponseRejected() {
+	s.response = `{"result":{"ProposeGasPrice":"` + strings.Repeat("1", maxOracleResponseSize) + `"}}`
+	gpd := NewGasOracleDeterminant(s.gasPricerMock, nil, s.oracleOpts)
+
+	err := gpd.Refresh(context.Background())
+
+	s.NotNil(err)
+}
+
+func (s *GasOracleTestSuite) TestNextPollDelay_BacksOffWhileOracleFails() {
+	gpd := NewGasOracleDeterminant(s.gasPricerMock, nil, s.oracleOpts)
+
+	delay := gpd.nextPollDelay(time.Minute, true)
+	s.Equal(2*time.Minute, delay)
+	delay = gpd.nextPollDelay(delay, true)
+	s.Equal(4*time.Minute, delay)
+	delay = gpd.nextPollDelay(8*time.Minute, true)
+	s.Equal(maxOracleBackoff, delay)
+	delay = gpd.nextPollDelay(delay, false)
+	s.Equal(time.Minute, delay)
+}
@@ -0,0 +1,179 @@
+package evmgaspricer
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/mitchellh/mapstructure"
+)
+
+const (
+	StaticGasPricer     = "static"
+	LondonGasPricer     = "london"
+	FeeHistoryGasPricer = "fee-history"
+	OracleGasPricer     = "oracle"
+)
+
+// GasPricerFactory creates gas pricer from the chain client, common gas pricer options and
+// options of the gas pricer type
+type GasPricerFactory func(client RegistryClient, opts *GasPricerOpts, options map[string]interface{}) (GasPricer, error)
+
+// Registry resolves gas pricer types from chain config into gas pricers
+type Registry struct {
+	factories map[string]GasPricerFactory
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]GasPricerFactory),
+	}
+}
+
+// NewDefaultRegistry creates registry with static, london, fee-history and oracle gas pricers
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.Register(StaticGasPricer, func(client RegistryClient, opts *GasPricerOpts, options map[string]interface{}) (GasPricer, error) {
+		return NewStaticGasPriceDeterminant(client, opts), nil
+	})
+	r.Register(LondonGasPricer, func(client RegistryClient, opts *GasPricerOpts, options map[string]interface{}) (GasPricer, error) {
+		return NewLondonGasPriceClient(client, opts), nil
+	})
+	r.Register(FeeHistoryGasPricer, newFeeHistoryGasPricer)
+	r.Register(OracleGasPricer, newOracleGasPricer)
+	return r
+}
+
+// Register registers gas pricer type, replacing existing one with the same name
+func (r *Registry) Register(name string, factory GasPricerFactory) {
+	r.factories[name] = factory
+}
+
+// GasPricer creates gas pricer of the registered type
+func (r *Registry) GasPricer(name string, client RegistryClient, opts *GasPricerOpts, options map[string]interface{}) (GasPricer, error) {
+	factory, ok := r.factories[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown gas pricer type %s", name)
+	}
+	gasPricer, err := factory(client, opts, options)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s gas pricer options: %w", name, err)
+	}
+	return gasPricer, nil
+}
+
+type rawPriorityFeeParams struct {
+	RewardPercentile float64 `mapstructure:"rewardPercentile"`
+	BaseFeeWindow    int     `mapstructure:"baseFeeWindow"`
+}
+
+type rawFeeHistoryOpts struct {
+	BlockCount      uint64                          `mapstructure:"blockCount"`
+	DefaultPriority string                          `mapstructure:"defaultPriority"`
+	Priorities      map[string]rawPriorityFeeParams `mapstructure:"priorities"`
+}
+
+// newFeeHistoryGasPricer creates fee history gas pricer with options overriding DefaultFeeHistoryOpts.
+// Priorities are keyed by transactor.TxPriorities names.
+func newFeeHistoryGasPricer(client RegistryClient, opts *GasPricerOpts, options map[string]interface{}) (GasPricer, error) {
+	var raw rawFeeHistoryOpts
+	err := mapstructure.Decode(options, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	feeOpts := FeeHistoryOpts{
+		BlockCount:      DefaultFeeHistoryOpts.BlockCount,
+		DefaultPriority: DefaultFeeHistoryOpts.DefaultPriority,
+		Priorities:      make(map[uint8]PriorityFeeParams),
+	}
+	for p, params := range DefaultFeeHistoryOpts.Priorities {
+		feeOpts.Priorities[p] = params
+	}
+	if raw.BlockCount != 0 {
+		feeOpts.BlockCount = raw.BlockCount
+	}
+	if raw.DefaultPriority != "" {
+		feeOpts.DefaultPriority, err = priority(raw.DefaultPriority)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for name, params := range raw.Priorities {
+		p, err := priority(name)
+		if err != nil {
+			return nil, err
+		}
+		feeOpts.Priorities[p] = PriorityFeeParams(params)
+	}
+	return NewFeeHistoryGasPriceDeterminant(client, opts, feeOpts), nil
+}
+
+type rawOracleOpts struct {
+	URL             string            `mapstructure:"url"`
+	PricePaths      map[string]string `mapstructure:"pricePaths"`
+	DefaultPriority string            `mapstructure:"defaultPriority"`
+	Unit            string            `mapstructure:"unit"`
+	PollInterval    uint64            `mapstructure:"pollInterval"`
+	StaleAfter      uint64            `mapstructure:"staleAfter"`
+	Timeout         uint64            `mapstructure:"timeout"`
+}
+
+// newOracleGasPricer creates gas oracle pricer. Price paths are keyed by transactor.TxPriorities
+// names, unit is either "wei" or "gwei" and intervals are in seconds.
+func newOracleGasPricer(client RegistryClient, opts *GasPricerOpts, options map[string]interface{}) (GasPricer, error) {
+	raw := rawOracleOpts{
+		DefaultPriority: "medium",
+		Unit:            "gwei",
+		PollInterval:    10,
+		StaleAfter:      60,
+		Timeout:         5,
+	}
+	err := mapstructure.Decode(options, &raw)
+	if err != nil {
+		return nil, err
+	}
+	if raw.URL == "" {
+		return nil, fmt.Errorf("oracle url required")
+	}
+
+	oracleOpts := OracleOpts{
+		URL:          raw.URL,
+		PricePaths:   make(map[uint8]string),
+		PollInterval: time.Duration(raw.PollInterval) * time.Second,
+		StaleAfter:   time.Duration(raw.StaleAfter) * time.Second,
+		Timeout:      time.Duration(raw.Timeout) * time.Second,
+	}
+	switch raw.Unit {
+	case "wei":
+		oracleOpts.Unit = big.NewInt(1)
+	case "gwei":
+		oracleOpts.Unit = big.NewInt(1000000000)
+	default:
+		return nil, fmt.Errorf("unsupported unit %s", raw.Unit)
+	}
+	oracleOpts.DefaultPriority, err = priority(raw.DefaultPriority)
+	if err != nil {
+		return nil, err
+	}
+	for name, path := range raw.PricePaths {
+		p, err := priority(name)
+		if err != nil {
+			return nil, err
+		}
+		oracleOpts.PricePaths[p] = path
+	}
+	if _, ok := oracleOpts.PricePaths[oracleOpts.DefaultPriority]; !ok {
+		return nil, fmt.Errorf("price path of default priority %s required", raw.DefaultPriority)
+	}
+	return NewGasOracleDeterminant(client, opts, oracleOpts), nil
+}
+
+func priority(name string) (uint8, error) {
+	p, ok := transactor.TxPriorities[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown priority %s", name)
+	}
+	return p, nil
+}
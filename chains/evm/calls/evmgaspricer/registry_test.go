@@ -0,0 +1,109 @@
+package evmgaspricer
+
+import (
+	"math/big"
+	"testing"
+
+	mock_evmgaspricer "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer/mock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RegistryTestSuite struct {
+	suite.Suite
+	registry   *Registry
+	clientMock *mock_evmgaspricer.MockRegistryClient
+}
+
+func TestRunRegistryTestSuite(t *testing.T) {
+	suite.Run(t, new(RegistryTestSuite))
+}
+
+func (s *RegistryTestSuite) SetupSuite()    {}
+func (s *RegistryTestSuite) TearDownSuite() {}
+func (s *RegistryTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.clientMock = mock_evmgaspricer.NewMockRegistryClient(gomockController)
+	s.registry = NewDefaultRegistry()
+}
+func (s *RegistryTestSuite) TearDownTest() {}
+
+func (s *RegistryTestSuite) TestGasPricer_UnknownType() {
+	_, err := s.registry.GasPricer("unknown", s.clientMock, nil, nil)
+
+	s.NotNil(err)
+}
+
+func (s *RegistryTestSuite) TestGasPricer_Static() {
+	opts := &GasPricerOpts{UpperLimitFeePerGas: big.NewInt(10)}
+
+	gasPricer, err := s.registry.GasPricer(StaticGasPricer, s.clientMock, opts, nil)
+
+	s.Nil(err)
+	s.Equal(NewStaticGasPriceDeterminant(s.clientMock, opts), gasPricer)
+}
+
+func (s *RegistryTestSuite) TestGasPricer_FeeHistoryOptions() {
+	gasPricer, err := s.registry.GasPricer(FeeHistoryGasPricer, s.clientMock, nil, map[string]interface{}{
+		"blockCount":      20,
+		"defaultPriority": "fast",
+		"priorities": map[string]interface{}{
+			"fast": map[string]interface{}{"rewardPercentile": 99, "baseFeeWindow": 8},
+		},
+	})
+
+	s.Nil(err)
+	feeOpts := gasPricer.(*FeeHistoryGasPriceDeterminant).feeOpts
+	s.Equal(uint64(20), feeOpts.BlockCount)
+	s.Equal(uint8(3), feeOpts.DefaultPriority)
+	s.Equal(PriorityFeeParams{RewardPercentile: 99, BaseFeeWindow: 8}, feeOpts.Priorities[3])
+	s.Equal(DefaultFeeHistoryOpts.Priorities[1], feeOpts.Priorities[1])
+	// defaults are not modified
+	s.Equal(PriorityFeeParams{RewardPercentile: 90, BaseFeeWindow: 6}, DefaultFeeHistoryOpts.Priorities[3])
+}
+
+func (s *RegistryTestSuite) TestGasPricer_FeeHistoryUnknownPriority() {
+	_, err := s.registry.GasPricer(FeeHistoryGasPricer, s.clientMock, nil, map[string]interface{}{
+		"priorities": map[string]interface{}{
+			"urgent": map[string]interface{}{"rewardPercentile": 99},
+		},
+	})
+
+	s.NotNil(err)
+}
+
+func (s *RegistryTestSuite) TestGasPricer_Oracle() {
+	gasPricer, err := s.registry.GasPricer(OracleGasPricer, s.clientMock, nil, map[string]interface{}{
+		"url": "https://gasstation.example.com",
+		"pricePaths": map[string]interface{}{
+			"slow":   "safeLow.maxFee",
+			"medium": "standard.maxFee",
+		},
+		"staleAfter": 30,
+	})
+
+	s.Nil(err)
+	oracleOpts := gasPricer.(*GasOracleDeterminant).oracleOpts
+	s.Equal(map[uint8]string{1: "safeLow.maxFee", 2: "standard.maxFee"}, oracleOpts.PricePaths)
+	s.Equal(uint8(2), oracleOpts.DefaultPriority)
+	s.Equal(big.NewInt(1000000000), oracleOpts.Unit)
+	s.Equal(float64(30), oracleOpts.StaleAfter.Seconds())
+}
+
+func (s *RegistryTestSuite) TestGasPricer_OracleWithoutDefaultPriorityPath() {
+	_, err := s.registry.GasPricer(OracleGasPricer, s.clientMock, nil, map[string]interface{}{
+		"url": "https://gasstation.example.com",
+		"pricePaths": map[string]interface{}{
+			"fast": "fast.maxFee",
+		},
+	})
+
+	s.NotNil(err)
+}
+
+func (s *RegistryTestSuite) TestGasPricer_OracleWithoutURL() {
+	_, err := s.registry.GasPricer(OracleGasPricer, s.clientMock, nil, map[string]interface{}{})
+
+	s.NotNil(err)
+}
@@ -0,0 +1,263 @@
+package calls
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	bundledABIs = []string{
+		consts.BridgeABI,
+		consts.CentrifugeAssetStoreABI,
+		consts.ERC20HandlerABI,
+		consts.ERC20PresetMinterPauserABI,
+		consts.ERC721HandlerABI,
+		consts.ERC721PresetMinterPauserABI,
+		consts.GenericHandlerABI,
+		consts.MinimalForwarderABI,
+	}
+)
+
+// panicReasons maps solidity Panic(uint256) codes to their meaning
+// https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid encoded storage byte array",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to uninitialized function",
+}
+
+// RevertError is returned when execution was reverted with an Error(string) reason
+type RevertError struct {
+	Reason string
+}
+
+func (e *RevertError) Error() string {
+	return fmt.Sprintf("execution reverted: %s", e.Reason)
+}
+
+// PanicError is returned when execution was reverted with a Panic(uint256) code
+type PanicError struct {
+	Code *big.Int
+}
+
+func (e *PanicError) Error() string {
+	reason := "unknown panic code"
+	if r, ok := panicReasons[e.Code.Uint64()]; ok && e.Code.IsUint64() {
+		reason = r
+	}
+	return fmt.Sprintf("execution reverted with panic 0x%x: %s", e.Code, reason)
+}
+
+// CustomError is returned when execution was reverted with a custom error declared in a known ABI
+type CustomError struct {
+	Name string
+	Args []interface{}
+}
+
+func (e *CustomError) Error() string {
+	return fmt.Sprintf("execution reverted with %s%v", e.Name, e.Args)
+}
+
+// UnknownRevertError is returned when execution was reverted without a reason or
+// with revert data that does not match any known error
+type UnknownRevertError struct {
+	Data []byte
+}
+
+func (e *UnknownRevertError) Error() string {
+	if len(e.Data) == 0 {
+		return "execution reverted"
+	}
+	return fmt.Sprintf("execution reverted with unknown data %s", hexutil.Encode(e.Data))
+}
+
+// IsRevert returns true if err is, or wraps, a decoded revert error.
+// Reverts are deterministic for the state they were executed against so
+// repeating the same call without a state change would fail the same way.
+func IsRevert(err error) bool {
+	var revertErr *RevertError
+	var panicErr *PanicError
+	var customErr *CustomError
+	var unknownErr *UnknownRevertError
+	return errors.As(err, &revertErr) ||
+		errors.As(err, &panicErr) ||
+		errors.As(err, &customErr) ||
+		errors.As(err, &unknownErr)
+}
+
+type ReplayCaller interface {
+	ContractCaller
+	GetTransactionByHash(h common.Hash) (tx *types.Transaction, isPending bool, err error)
+}
+
+// RevertDecoder converts revert data returned by the node into typed errors
+type RevertDecoder struct {
+	customErrors map[[4]byte]abi.Error
+}
+
+// NewRevertDecoder creates a RevertDecoder that recognises custom errors declared in
+// bundled contract ABIs and in provided ABIs
+func NewRevertDecoder(abis ...abi.ABI) *RevertDecoder {
+	d := &RevertDecoder{customErrors: make(map[[4]byte]abi.Error)}
+	for _, a := range parseBundledABIs() {
+		d.registerErrors(a)
+	}
+	for _, a := range abis {
+		d.registerErrors(a)
+	}
+	return d
+}
+
+var (
+	bundledABIsOnce   sync.Once
+	parsedBundledABIs []abi.ABI
+)
+
+func parseBundledABIs() []abi.ABI {
+	bundledABIsOnce.Do(func() {
+		for _, rawABI := range bundledABIs {
+			a, err := abi.JSON(strings.NewReader(rawABI))
+			if err != nil {
+				continue
+			}
+			parsedBundledABIs = append(parsedBundledABIs, a)
+		}
+	})
+	return parsedBundledABIs
+}
+
+func (d *RevertDecoder) registerErrors(a abi.ABI) {
+	for _, e := range a.Errors {
+		var selector [4]byte
+		copy(selector[:], e.ID[:4])
+		d.customErrors[selector] = e
+	}
+}
+
+// Decode converts raw revert data into RevertError, PanicError, CustomError or UnknownRevertError
+func (d *RevertDecoder) Decode(data []byte) error {
+	if len(data) < 4 {
+		return &UnknownRevertError{Data: data}
+	}
+
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		reason, err := abi.UnpackRevert(data)
+		if err != nil {
+			return &UnknownRevertError{Data: data}
+		}
+		return &RevertError{Reason: reason}
+	case bytes.Equal(data[:4], panicSelector):
+		if len(data) != 36 {
+			return &UnknownRevertError{Data: data}
+		}
+		return &PanicError{Code: new(big.Int).SetBytes(data[4:])}
+	}
+
+	var selector [4]byte
+	copy(selector[:], data[:4])
+	customError, ok := d.customErrors[selector]
+	if !ok {
+		return &UnknownRevertError{Data: data}
+	}
+	args, err := customError.Inputs.Unpack(data[4:])
+	if err != nil {
+		return &UnknownRevertError{Data: data}
+	}
+	return &CustomError{Name: customError.Name, Args: args}
+}
+
+// DecodeError extracts revert data from an RPC error and decodes it.
+// Errors that are not reverts are returned unchanged.
+func (d *RevertDecoder) DecodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if rawData, ok := dataErr.ErrorData().(string); ok {
+			data, decodeErr := hexutil.Decode(rawData)
+			if decodeErr == nil {
+				return d.Decode(data)
+			}
+		}
+	}
+
+	// some nodes return the reason only as part of the error message
+	msg := err.Error()
+	if strings.HasPrefix(msg, "execution reverted: ") {
+		return &RevertError{Reason: strings.TrimPrefix(msg, "execution reverted: ")}
+	}
+	if msg == "execution reverted" {
+		return &UnknownRevertError{}
+	}
+	return err
+}
+
+// DecodeFailedTransaction replays failed transaction with eth_call against the state of the
+// block before the one it was included in to recover its revert reason.
+// If the reason can not be recovered, provided failure is returned.
+func (d *RevertDecoder) DecodeFailedTransaction(c ReplayCaller, failure *evmclient.TransactionFailedError) error {
+	receipt := failure.Receipt
+	tx, _, err := c.GetTransactionByHash(receipt.TxHash)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to fetch failed transaction %s", receipt.TxHash)
+		return failure
+	}
+
+	from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to recover sender of failed transaction %s", receipt.TxHash)
+		return failure
+	}
+
+	msg := ethereum.CallMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	var parent *big.Int
+	if receipt.BlockNumber != nil && receipt.BlockNumber.Sign() > 0 {
+		parent = new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	}
+	_, err = c.CallContract(context.TODO(), ToCallArg(msg), parent)
+	if err == nil {
+		// replay succeeded, so transaction most probably ran out of gas
+		return failure
+	}
+
+	revertErr := d.DecodeError(err)
+	if !IsRevert(revertErr) {
+		return failure
+	}
+	return fmt.Errorf("transaction %s reverted: %w", receipt.TxHash, revertErr)
+}
@@ -0,0 +1,153 @@
+package calls_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	// Error("proposal already passed")
+	errorStringData = "0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000001770726f706f73616c20616c726561647920706173736564000000000000000000"
+	// Panic(0x11)
+	panicData        = "0x4e487b710000000000000000000000000000000000000000000000000000000000000011"
+	customErrorABI   = `[{"inputs":[{"internalType":"uint64","name":"depositNonce","type":"uint64"}],"name":"ProposalExpired","type":"error"}]`
+	customErrorData  = "0x" + common.Bytes2Hex(crypto.Keccak256([]byte("ProposalExpired(uint64)"))[:4]) + "0000000000000000000000000000000000000000000000000000000000000005"
+	unknownErrorData = "0xdeadbeef"
+)
+
+type rpcDataError struct {
+	data interface{}
+}
+
+func (e rpcDataError) Error() string          { return "execution reverted" }
+func (e rpcDataError) ErrorCode() int         { return 3 }
+func (e rpcDataError) ErrorData() interface{} { return e.data }
+
+type RevertDecoderTestSuite struct {
+	suite.Suite
+	decoder    *calls.RevertDecoder
+	mockClient *mock_calls.MockContractCallerDispatcher
+}
+
+func TestRunRevertDecoderTestSuite(t *testing.T) {
+	suite.Run(t, new(RevertDecoderTestSuite))
+}
+
+func (s *RevertDecoderTestSuite) SetupSuite()    {}
+func (s *RevertDecoderTestSuite) TearDownSuite() {}
+func (s *RevertDecoderTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_calls.NewMockContractCallerDispatcher(gomockController)
+	a, _ := abi.JSON(strings.NewReader(customErrorABI))
+	s.decoder = calls.NewRevertDecoder(a)
+}
+func (s *RevertDecoderTestSuite) TearDownTest() {}
+
+func (s *RevertDecoderTestSuite) TestDecode_ErrorString() {
+	err := s.decoder.Decode(hexutil.MustDecode(errorStringData))
+
+	var revertErr *calls.RevertError
+	s.True(errors.As(err, &revertErr))
+	s.Equal("proposal already passed", revertErr.Reason)
+	s.True(calls.IsRevert(err))
+}
+
+func (s *RevertDecoderTestSuite) TestDecode_Panic() {
+	err := s.decoder.Decode(hexutil.MustDecode(panicData))
+
+	var panicErr *calls.PanicError
+	s.True(errors.As(err, &panicErr))
+	s.Equal(big.NewInt(0x11), panicErr.Code)
+	s.Equal("execution reverted with panic 0x11: arithmetic underflow or overflow", err.Error())
+}
+
+func (s *RevertDecoderTestSuite) TestDecode_CustomError() {
+	err := s.decoder.Decode(hexutil.MustDecode(customErrorData))
+
+	var customErr *calls.CustomError
+	s.True(errors.As(err, &customErr))
+	s.Equal("ProposalExpired", customErr.Name)
+	s.Equal([]interface{}{uint64(5)}, customErr.Args)
+}
+
+func (s *RevertDecoderTestSuite) TestDecode_UnknownData() {
+	err := s.decoder.Decode(hexutil.MustDecode(unknownErrorData))
+
+	var unknownErr *calls.UnknownRevertError
+	s.True(errors.As(err, &unknownErr))
+	s.True(calls.IsRevert(err))
+}
+
+func (s *RevertDecoderTestSuite) TestDecodeError_RPCDataError() {
+	err := s.decoder.DecodeError(rpcDataError{data: errorStringData})
+
+	s.Equal(&calls.RevertError{Reason: "proposal already passed"}, err)
+}
+
+func (s *RevertDecoderTestSuite) TestDecodeError_ReasonInMessage() {
+	err := s.decoder.DecodeError(errors.New("execution reverted: relayer already voted"))
+
+	s.Equal(&calls.RevertError{Reason: "relayer already voted"}, err)
+}
+
+func (s *RevertDecoderTestSuite) TestDecodeError_NotRevert() {
+	original := errors.New("connection refused")
+
+	err := s.decoder.DecodeError(original)
+
+	s.Equal(original, err)
+	s.False(calls.IsRevert(err))
+}
+
+func (s *RevertDecoderTestSuite) TestDecodeFailedTransaction_Reverted() {
+	key, _ := crypto.GenerateKey()
+	to := common.HexToAddress("0x1")
+	tx, _ := types.SignTx(
+		types.NewTransaction(1, to, big.NewInt(0), 100000, big.NewInt(1), []byte{1, 2, 3, 4}),
+		types.NewEIP155Signer(big.NewInt(5)),
+		key,
+	)
+	receipt := &types.Receipt{Status: 0, TxHash: tx.Hash(), BlockNumber: big.NewInt(10)}
+	s.mockClient.EXPECT().GetTransactionByHash(tx.Hash()).Return(tx, false, nil)
+	s.mockClient.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(9)).DoAndReturn(
+		func(_ interface{}, callArgs map[string]interface{}, _ *big.Int) ([]byte, error) {
+			s.Equal(crypto.PubkeyToAddress(key.PublicKey), callArgs["from"])
+			return nil, rpcDataError{data: errorStringData}
+		})
+
+	err := s.decoder.DecodeFailedTransaction(s.mockClient, &evmclient.TransactionFailedError{Receipt: receipt})
+
+	var revertErr *calls.RevertError
+	s.True(errors.As(err, &revertErr))
+	s.Equal("proposal already passed", revertErr.Reason)
+}
+
+func (s *RevertDecoderTestSuite) TestDecodeFailedTransaction_ReplaySucceeds() {
+	key, _ := crypto.GenerateKey()
+	tx, _ := types.SignTx(
+		types.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(0), 100000, big.NewInt(1), nil),
+		types.NewEIP155Signer(big.NewInt(5)),
+		key,
+	)
+	failure := &evmclient.TransactionFailedError{Receipt: &types.Receipt{TxHash: tx.Hash(), BlockNumber: big.NewInt(10)}}
+	s.mockClient.EXPECT().GetTransactionByHash(tx.Hash()).Return(tx, false, nil)
+	s.mockClient.EXPECT().CallContract(gomock.Any(), gomock.Any(), big.NewInt(9)).Return([]byte{}, nil)
+
+	err := s.decoder.DecodeFailedTransaction(s.mockClient, failure)
+
+	s.Equal(failure, err)
+	s.False(calls.IsRevert(err))
+}
@@ -0,0 +1,158 @@
+package transactor
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type TxStatus uint8
+
+const (
+	TxPending TxStatus = iota
+	TxMined
+	TxReverted
+	TxDropped
+)
+
+// TxResult is the final outcome of the transaction sent with TransactAsync
+type TxResult struct {
+	Status TxStatus
+	// Receipt of mined or reverted transaction
+	Receipt *types.Receipt
+	// Replaced is true if replacement of the originally sent transaction was mined
+	Replaced bool
+	// Err is the reason transaction was dropped
+	Err error
+}
+
+// TxHandle is returned by TransactAsync right after transaction is broadcast and
+// is resolved once the transaction, or one of its replacements, is mined or dropped
+type TxHandle struct {
+	hash  common.Hash
+	nonce uint64
+
+	lock       sync.Mutex
+	done       chan struct{}
+	result     *TxResult
+	onMined    []func(receipt *types.Receipt)
+	onReverted []func(receipt *types.Receipt)
+	onDropped  []func(err error)
+}
+
+func NewTxHandle(hash common.Hash, nonce uint64) *TxHandle {
+	return &TxHandle{
+		hash:  hash,
+		nonce: nonce,
+		done:  make(chan struct{}),
+	}
+}
+
+// Hash returns hash of the originally sent transaction
+func (h *TxHandle) Hash() common.Hash {
+	return h.hash
+}
+
+func (h *TxHandle) Nonce() uint64 {
+	return h.nonce
+}
+
+// Done returns channel that is closed when handle is resolved
+func (h *TxHandle) Done() <-chan struct{} {
+	return h.done
+}
+
+// Wait blocks until handle is resolved and returns the transaction outcome
+func (h *TxHandle) Wait() *TxResult {
+	<-h.done
+	return h.result
+}
+
+// OnMined registers callback called with receipt of successfully mined transaction.
+// Callback is called immediately if transaction was already mined.
+func (h *TxHandle) OnMined(f func(receipt *types.Receipt)) *TxHandle {
+	h.lock.Lock()
+	if h.result == nil {
+		h.onMined = append(h.onMined, f)
+		h.lock.Unlock()
+		return h
+	}
+	h.lock.Unlock()
+
+	if h.result.Status == TxMined {
+		f(h.result.Receipt)
+	}
+	return h
+}
+
+// OnReverted registers callback called with receipt of mined transaction that failed.
+// Callback is called immediately if transaction was already reverted.
+func (h *TxHandle) OnReverted(f func(receipt *types.Receipt)) *TxHandle {
+	h.lock.Lock()
+	if h.result == nil {
+		h.onReverted = append(h.onReverted, f)
+		h.lock.Unlock()
+		return h
+	}
+	h.lock.Unlock()
+
+	if h.result.Status == TxReverted {
+		f(h.result.Receipt)
+	}
+	return h
+}
+
+// OnDropped registers callback called when transaction will not be mined.
+// Callback is called immediately if transaction was already dropped.
+func (h *TxHandle) OnDropped(f func(err error)) *TxHandle {
+	h.lock.Lock()
+	if h.result == nil {
+		h.onDropped = append(h.onDropped, f)
+		h.lock.Unlock()
+		return h
+	}
+	h.lock.Unlock()
+
+	if h.result.Status == TxDropped {
+		f(h.result.Err)
+	}
+	return h
+}
+
+// Resolve sets outcome of the transaction and calls registered callbacks.
+// Handle can be resolved only once, later calls are ignored.
+func (h *TxHandle) Resolve(result *TxResult) {
+	h.lock.Lock()
+	if h.result != nil {
+		h.lock.Unlock()
+		return
+	}
+	h.result = result
+	onMined, onReverted, onDropped := h.onMined, h.onReverted, h.onDropped
+	h.onMined, h.onReverted, h.onDropped = nil, nil, nil
+	close(h.done)
+	h.lock.Unlock()
+
+	switch result.Status {
+	case TxMined:
+		for _, f := range onMined {
+			f(result.Receipt)
+		}
+	case TxReverted:
+		for _, f := range onReverted {
+			f(result.Receipt)
+		}
+	case TxDropped:
+		for _, f := range onDropped {
+			f(result.Err)
+		}
+	}
+}
+
+var ErrAsyncNotSupported = errors.New("transactor does not support asynchronous transactions")
+
+type AsyncTransactor interface {
+	TransactAsync(to *common.Address, data []byte, opts TransactOptions) (*TxHandle, error)
+}
@@ -0,0 +1,73 @@
+package transactor_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type TxHandleTestSuite struct {
+	suite.Suite
+	handle *transactor.TxHandle
+}
+
+func TestRunTxHandleTestSuite(t *testing.T) {
+	suite.Run(t, new(TxHandleTestSuite))
+}
+
+func (s *TxHandleTestSuite) SetupSuite()    {}
+func (s *TxHandleTestSuite) TearDownSuite() {}
+func (s *TxHandleTestSuite) SetupTest() {
+	s.handle = transactor.NewTxHandle(common.Hash{1}, 5)
+}
+func (s *TxHandleTestSuite) TearDownTest() {}
+
+func (s *TxHandleTestSuite) TestResolve_CallsMatchingCallbacks() {
+	mined := 0
+	dropped := 0
+	s.handle.OnMined(func(receipt *types.Receipt) {
+		mined++
+	}).OnDropped(func(err error) {
+		dropped++
+	})
+
+	s.handle.Resolve(&transactor.TxResult{Status: transactor.TxMined, Receipt: &types.Receipt{}})
+
+	s.Equal(1, mined)
+	s.Equal(0, dropped)
+	s.Equal(transactor.TxMined, s.handle.Wait().Status)
+}
+
+func (s *TxHandleTestSuite) TestResolve_CallbackRegisteredAfterResolve() {
+	s.handle.Resolve(&transactor.TxResult{Status: transactor.TxDropped, Err: errors.New("error")})
+
+	var droppedErr error
+	s.handle.OnDropped(func(err error) {
+		droppedErr = err
+	})
+
+	s.NotNil(droppedErr)
+	select {
+	case <-s.handle.Done():
+	default:
+		s.Fail("handle should be done")
+	}
+}
+
+func (s *TxHandleTestSuite) TestResolve_OnlyFirstResultApplied() {
+	reverted := 0
+	s.handle.OnReverted(func(receipt *types.Receipt) {
+		reverted++
+	})
+
+	s.handle.Resolve(&transactor.TxResult{Status: transactor.TxReverted, Receipt: &types.Receipt{}})
+	s.handle.Resolve(&transactor.TxResult{Status: transactor.TxReverted, Receipt: &types.Receipt{}})
+
+	s.Equal(1, reverted)
+	s.Equal(common.Hash{1}, s.handle.Hash())
+	s.Equal(uint64(5), s.handle.Nonce())
+}
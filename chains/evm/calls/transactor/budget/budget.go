@@ -0,0 +1,313 @@
+package budget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	HourPeriod = "hour"
+	DayPeriod  = "day"
+)
+
+var (
+	ErrSpendingPaused = errors.New("sending transactions paused after spending budget was exceeded")
+	ErrOverBudget     = errors.New("estimated transaction cost exceeds remaining spending budget")
+)
+
+type CostClient interface {
+	TransactionCost(ctx context.Context, txHash common.Hash) (*big.Int, error)
+}
+
+// CostEstimator estimates total cost of the transaction before it is sent
+type CostEstimator interface {
+	EstimateTransactionCost(to *common.Address, data []byte, opts transactor.TransactOptions) (*big.Int, error)
+}
+
+type SpendingStore interface {
+	StoreSpending(domainID uint8, period string, window int64, spent *big.Int) error
+	GetSpending(domainID uint8, period string, window int64) (*big.Int, error)
+	PruneSpending(domainID uint8, period string, before int64) error
+	StorePaused(domainID uint8, paused bool) error
+	GetPaused(domainID uint8) (bool, error)
+}
+
+// Budget limits wei spent on transaction fees per hour and per day. Nil limits are not applied.
+type Budget struct {
+	Hourly *big.Int
+	Daily  *big.Int
+}
+
+// Alert describes spending budget exceeded on the domain
+type Alert struct {
+	DomainID uint8
+	Period   string
+	Spent    *big.Int
+	Budget   *big.Int
+}
+
+func (a *Alert) Error() string {
+	return fmt.Sprintf("relayer spent %s wei on domain %d in the last %s, exceeding budget of %s wei", a.Spent, a.DomainID, a.Period, a.Budget)
+}
+
+type AlertReporter interface {
+	Report(alert *Alert)
+}
+
+// LogAlertReporter is AlertReporter that logs alerts
+type LogAlertReporter struct{}
+
+func (r *LogAlertReporter) Report(alert *Alert) {
+	log.Error().
+		Uint8("domainID", alert.DomainID).
+		Str("period", alert.Period).
+		Msgf("Spending budget exceeded, sending transactions paused: %s", alert.Error())
+}
+
+// SpendingGuard is a transactor that tracks fees paid by mined transactions as gas used
+// multiplied by effective gas price. Once spending in the current hour or day reaches its
+// budget, sending is paused and an alert is raised. Pause is persisted until it is lifted with Resume.
+// With a cost estimator, transactions whose estimated cost exceeds the remaining budget are not sent.
+type SpendingGuard struct {
+	transactor transactor.Transactor
+	client     CostClient
+	store      SpendingStore
+	domainID   uint8
+	budget     Budget
+	reporter   AlertReporter
+	estimator  CostEstimator
+
+	lock   sync.Mutex
+	paused bool
+}
+
+func NewSpendingGuard(t transactor.Transactor, client CostClient, store SpendingStore, domainID uint8, budget Budget, reporter AlertReporter) (*SpendingGuard, error) {
+	paused, err := store.GetPaused(domainID)
+	if err != nil {
+		return nil, err
+	}
+	if paused {
+		log.Warn().Uint8("domainID", domainID).Msg("Sending transactions paused after spending budget was exceeded")
+	}
+	return &SpendingGuard{
+		transactor: t,
+		client:     client,
+		store:      store,
+		domainID:   domainID,
+		budget:     budget,
+		reporter:   reporter,
+		paused:     paused,
+	}, nil
+}
+
+// SetCostEstimator makes guard check estimated cost of transactions against the remaining budget
+// before they are sent
+func (g *SpendingGuard) SetCostEstimator(estimator CostEstimator) {
+	g.estimator = estimator
+}
+
+// Transact sends transaction unless sending is paused and records its fee once it is mined.
+// Fee of mined cancellation is recorded as well if the transactor supports async transactions.
+func (g *SpendingGuard) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+	if g.Paused() {
+		return &common.Hash{}, ErrSpendingPaused
+	}
+	err := g.checkEstimate(to, data, opts)
+	if err != nil {
+		return &common.Hash{}, err
+	}
+
+	if t, ok := g.transactor.(transactor.AsyncTransactor); ok {
+		handle, err := t.TransactAsync(to, data, opts)
+		if err != nil {
+			return &common.Hash{}, err
+		}
+		result := handle.Wait()
+		g.recordResult(result)
+		switch result.Status {
+		case transactor.TxMined:
+			return &result.Receipt.TxHash, nil
+		case transactor.TxReverted:
+			return &common.Hash{}, &evmclient.TransactionFailedError{Receipt: result.Receipt}
+		default:
+			return &common.Hash{}, result.Err
+		}
+	}
+
+	hash, err := g.transactor.Transact(to, data, opts)
+	var txErr *evmclient.TransactionFailedError
+	switch {
+	case err == nil:
+		g.record(*hash)
+	case errors.As(err, &txErr):
+		g.record(txErr.Receipt.TxHash)
+	}
+	return hash, err
+}
+
+// TransactAsync sends transaction unless sending is paused and records its fee once the
+// transaction handle is resolved with a receipt, including receipt of mined cancellation of dropped transaction
+func (g *SpendingGuard) TransactAsync(to *common.Address, data []byte, opts transactor.TransactOptions) (*transactor.TxHandle, error) {
+	if g.Paused() {
+		return nil, ErrSpendingPaused
+	}
+	err := g.checkEstimate(to, data, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := g.transactor.(transactor.AsyncTransactor)
+	if !ok {
+		return nil, transactor.ErrAsyncNotSupported
+	}
+	handle, err := t.TransactAsync(to, data, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	record := func(receipt *types.Receipt) {
+		g.record(receipt.TxHash)
+	}
+	return handle.OnMined(record).OnReverted(record).OnDropped(func(err error) {
+		// dropped transaction handle is resolved before callbacks are called
+		g.recordResult(handle.Wait())
+	}), nil
+}
+
+// recordResult records fee of the transaction outcome if any transaction was mined
+func (g *SpendingGuard) recordResult(result *transactor.TxResult) {
+	if result.Receipt != nil {
+		g.record(result.Receipt.TxHash)
+	}
+}
+
+func (g *SpendingGuard) Paused() bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.paused
+}
+
+// Resume is an admin override that lifts the pause. Spending in the periods that exceeded
+// their budget is not limited anymore, the guard pauses again once a later period exceeds its budget.
+func (g *SpendingGuard) Resume() error {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	err := g.store.StorePaused(g.domainID, false)
+	if err != nil {
+		return err
+	}
+	g.paused = false
+	log.Info().Uint8("domainID", g.domainID).Msg("Sending transactions resumed")
+	return nil
+}
+
+// record adds fee paid by the transaction to spending of the current hour and day.
+// Windows older than a day are pruned when spending of a new window is stored.
+func (g *SpendingGuard) record(txHash common.Hash) {
+	cost, err := g.client.TransactionCost(context.TODO(), txHash)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Failed fetching cost of transaction %s", txHash)
+		return
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	for _, l := range g.limits() {
+		spent, err := g.store.GetSpending(g.domainID, l.period, l.window)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed fetching spending of the %s", l.period)
+			continue
+		}
+		total := new(big.Int).Add(spent, cost)
+		err = g.store.StoreSpending(g.domainID, l.period, l.window, total)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed storing spending of the %s", l.period)
+			continue
+		}
+		if spent.Sign() == 0 {
+			err = g.store.PruneSpending(g.domainID, l.period, l.window-l.windowsPerDay)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Failed pruning spending of past %s windows", l.period)
+			}
+		}
+
+		// budget is exceeded by the transaction that crosses it, so the guard does
+		// not pause again in the same period after it was resumed
+		if l.budget != nil && spent.Cmp(l.budget) < 0 && total.Cmp(l.budget) >= 0 {
+			g.pause(&Alert{
+				DomainID: g.domainID,
+				Period:   l.period,
+				Spent:    total,
+				Budget:   l.budget,
+			})
+		}
+	}
+}
+
+// checkEstimate returns ErrOverBudget if estimated cost of the transaction would exceed the budget
+// of a period. Periods whose budget was already exceeded are not limited, as sending was resumed.
+func (g *SpendingGuard) checkEstimate(to *common.Address, data []byte, opts transactor.TransactOptions) error {
+	if g.estimator == nil {
+		return nil
+	}
+	cost, err := g.estimator.EstimateTransactionCost(to, data, opts)
+	if err != nil {
+		log.Warn().Err(err).Msg("Failed estimating transaction cost, sending without budget check")
+		return nil
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	for _, l := range g.limits() {
+		if l.budget == nil {
+			continue
+		}
+		spent, err := g.store.GetSpending(g.domainID, l.period, l.window)
+		if err != nil {
+			log.Error().Err(err).Msgf("Failed fetching spending of the %s", l.period)
+			continue
+		}
+		if spent.Cmp(l.budget) < 0 && new(big.Int).Add(spent, cost).Cmp(l.budget) > 0 {
+			return fmt.Errorf("%w: estimated %s wei with %s of %s wei spent in the last %s", ErrOverBudget, cost, spent, l.budget, l.period)
+		}
+	}
+	return nil
+}
+
+type limit struct {
+	period        string
+	window        int64
+	windowsPerDay int64
+	budget        *big.Int
+}
+
+// limits returns current hour and day windows with their budgets
+func (g *SpendingGuard) limits() []limit {
+	now := time.Now().Unix()
+	return []limit{
+		{HourPeriod, now / int64(time.Hour/time.Second), 24, g.budget.Hourly},
+		{DayPeriod, now / int64(24*time.Hour/time.Second), 1, g.budget.Daily},
+	}
+}
+
+func (g *SpendingGuard) pause(alert *Alert) {
+	g.paused = true
+	err := g.store.StorePaused(g.domainID, true)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed storing spending pause")
+	}
+	g.reporter.Report(alert)
+}
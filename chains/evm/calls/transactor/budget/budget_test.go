@@ -0,0 +1,339 @@
+package budget_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/budget"
+	mock_budget "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/budget/mock"
+	mock_transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+// asyncTransactor is a mock transactor that returns the handle from TransactAsync
+type asyncTransactor struct {
+	*mock_transactor.MockTransactor
+	handle *transactor.TxHandle
+}
+
+func (t *asyncTransactor) TransactAsync(to *common.Address, data []byte, opts transactor.TransactOptions) (*transactor.TxHandle, error) {
+	return t.handle, nil
+}
+
+type SpendingGuardTestSuite struct {
+	suite.Suite
+	mockTransactor    *mock_transactor.MockTransactor
+	mockCostClient    *mock_budget.MockCostClient
+	mockSpendingStore *mock_budget.MockSpendingStore
+	mockAlertReporter *mock_budget.MockAlertReporter
+	budget            budget.Budget
+	to                common.Address
+}
+
+func TestRunSpendingGuardTestSuite(t *testing.T) {
+	suite.Run(t, new(SpendingGuardTestSuite))
+}
+
+func (s *SpendingGuardTestSuite) SetupSuite()    {}
+func (s *SpendingGuardTestSuite) TearDownSuite() {}
+func (s *SpendingGuardTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockTransactor = mock_transactor.NewMockTransactor(gomockController)
+	s.mockCostClient = mock_budget.NewMockCostClient(gomockController)
+	s.mockSpendingStore = mock_budget.NewMockSpendingStore(gomockController)
+	s.mockAlertReporter = mock_budget.NewMockAlertReporter(gomockController)
+	s.budget = budget.Budget{Hourly: big.NewInt(10), Daily: big.NewInt(100)}
+	s.to = common.HexToAddress("0x04005C8A516292af163b1AFe3D855b9f4f4631B5")
+}
+func (s *SpendingGuardTestSuite) TearDownTest() {}
+
+func (s *SpendingGuardTestSuite) newGuard(paused bool) *budget.SpendingGuard {
+	s.mockSpendingStore.EXPECT().GetPaused(uint8(1)).Return(paused, nil)
+	guard, err := budget.NewSpendingGuard(s.mockTransactor, s.mockCostClient, s.mockSpendingStore, 1, s.budget, s.mockAlertReporter)
+	s.Nil(err)
+	return guard
+}
+
+func (s *SpendingGuardTestSuite) expectSpending(period string, spent int64, total int64) {
+	s.mockSpendingStore.EXPECT().GetSpending(uint8(1), period, gomock.Any()).Return(big.NewInt(spent), nil)
+	s.mockSpendingStore.EXPECT().StoreSpending(uint8(1), period, gomock.Any(), big.NewInt(total)).Return(nil)
+	if spent == 0 {
+		s.mockSpendingStore.EXPECT().PruneSpending(uint8(1), period, gomock.Any()).Return(nil)
+	}
+}
+
+func (s *SpendingGuardTestSuite) newAsyncGuard(handle *transactor.TxHandle) *budget.SpendingGuard {
+	s.mockSpendingStore.EXPECT().GetPaused(uint8(1)).Return(false, nil)
+	t := &asyncTransactor{MockTransactor: s.mockTransactor, handle: handle}
+	guard, err := budget.NewSpendingGuard(t, s.mockCostClient, s.mockSpendingStore, 1, s.budget, s.mockAlertReporter)
+	s.Nil(err)
+	return guard
+}
+
+func (s *SpendingGuardTestSuite) TestNewSpendingGuard_FetchingPauseFails() {
+	s.mockSpendingStore.EXPECT().GetPaused(uint8(1)).Return(false, errors.New("error"))
+
+	_, err := budget.NewSpendingGuard(s.mockTransactor, s.mockCostClient, s.mockSpendingStore, 1, s.budget, s.mockAlertReporter)
+
+	s.NotNil(err)
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_PausedOnStart() {
+	guard := s.newGuard(true)
+
+	_, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Equal(budget.ErrSpendingPaused, err)
+	s.True(guard.Paused())
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_CostRecorded() {
+	guard := s.newGuard(false)
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{1}).Return(big.NewInt(5), nil)
+	s.expectSpending(budget.HourPeriod, 2, 7)
+	s.expectSpending(budget.DayPeriod, 20, 25)
+
+	hash, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(&common.Hash{1}, hash)
+	s.False(guard.Paused())
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_RevertedTransactionCostRecorded() {
+	guard := s.newGuard(false)
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{}, &evmclient.TransactionFailedError{
+		Receipt: &types.Receipt{TxHash: common.Hash{1}},
+	})
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{1}).Return(big.NewInt(5), nil)
+	s.expectSpending(budget.HourPeriod, 0, 5)
+	s.expectSpending(budget.DayPeriod, 0, 5)
+
+	_, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_SendingFails() {
+	guard := s.newGuard(false)
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{}, errors.New("error"))
+
+	_, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_BudgetExceeded() {
+	guard := s.newGuard(false)
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{1}).Return(big.NewInt(5), nil)
+	s.expectSpending(budget.HourPeriod, 8, 13)
+	s.expectSpending(budget.DayPeriod, 20, 25)
+	s.mockSpendingStore.EXPECT().StorePaused(uint8(1), true).Return(nil)
+	s.mockAlertReporter.EXPECT().Report(&budget.Alert{
+		DomainID: 1,
+		Period:   budget.HourPeriod,
+		Spent:    big.NewInt(13),
+		Budget:   big.NewInt(10),
+	})
+
+	_, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+	s.True(guard.Paused())
+
+	_, err = guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+	s.Equal(budget.ErrSpendingPaused, err)
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_ResumedGuardNotPausedAgainInSamePeriod() {
+	guard := s.newGuard(true)
+	s.mockSpendingStore.EXPECT().StorePaused(uint8(1), false).Return(nil)
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{1}).Return(big.NewInt(5), nil)
+	s.expectSpending(budget.HourPeriod, 13, 18)
+	s.expectSpending(budget.DayPeriod, 25, 30)
+
+	err := guard.Resume()
+	s.Nil(err)
+	_, err = guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.False(guard.Paused())
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_FetchingCostFails() {
+	guard := s.newGuard(false)
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{1}).Return(nil, errors.New("error"))
+
+	hash, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(&common.Hash{1}, hash)
+}
+
+func (s *SpendingGuardTestSuite) TestResume_StoringPauseFails() {
+	guard := s.newGuard(true)
+	s.mockSpendingStore.EXPECT().StorePaused(uint8(1), false).Return(errors.New("error"))
+
+	err := guard.Resume()
+
+	s.NotNil(err)
+	s.True(guard.Paused())
+}
+
+func (s *SpendingGuardTestSuite) TestTransactAsync_NotSupported() {
+	guard := s.newGuard(false)
+
+	_, err := guard.TransactAsync(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Equal(transactor.ErrAsyncNotSupported, err)
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_EstimateOverBudgetNotSent() {
+	guard := s.newGuard(false)
+	estimator := mock_budget.NewMockCostEstimator(gomock.NewController(s.T()))
+	guard.SetCostEstimator(estimator)
+	estimator.EXPECT().EstimateTransactionCost(&s.to, gomock.Any(), gomock.Any()).Return(big.NewInt(5), nil)
+	s.mockSpendingStore.EXPECT().GetSpending(uint8(1), budget.HourPeriod, gomock.Any()).Return(big.NewInt(6), nil)
+
+	_, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.True(errors.Is(err, budget.ErrOverBudget))
+	s.False(guard.Paused())
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_EstimateWithinBudgetSent() {
+	guard := s.newGuard(false)
+	estimator := mock_budget.NewMockCostEstimator(gomock.NewController(s.T()))
+	guard.SetCostEstimator(estimator)
+	estimator.EXPECT().EstimateTransactionCost(&s.to, gomock.Any(), gomock.Any()).Return(big.NewInt(5), nil)
+	s.mockSpendingStore.EXPECT().GetSpending(uint8(1), budget.HourPeriod, gomock.Any()).Return(big.NewInt(2), nil)
+	s.mockSpendingStore.EXPECT().GetSpending(uint8(1), budget.DayPeriod, gomock.Any()).Return(big.NewInt(2), nil)
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{1}).Return(big.NewInt(5), nil)
+	s.expectSpending(budget.HourPeriod, 2, 7)
+	s.expectSpending(budget.DayPeriod, 2, 7)
+
+	hash, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(&common.Hash{1}, hash)
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_EstimateNotCheckedInResumedPeriod() {
+	guard := s.newGuard(false)
+	estimator := mock_budget.NewMockCostEstimator(gomock.NewController(s.T()))
+	guard.SetCostEstimator(estimator)
+	estimator.EXPECT().EstimateTransactionCost(&s.to, gomock.Any(), gomock.Any()).Return(big.NewInt(5), nil)
+	s.mockSpendingStore.EXPECT().GetSpending(uint8(1), budget.HourPeriod, gomock.Any()).Return(big.NewInt(12), nil)
+	s.mockSpendingStore.EXPECT().GetSpending(uint8(1), budget.DayPeriod, gomock.Any()).Return(big.NewInt(12), nil)
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{1}).Return(big.NewInt(5), nil)
+	s.expectSpending(budget.HourPeriod, 12, 17)
+	s.expectSpending(budget.DayPeriod, 12, 17)
+
+	_, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_EstimationFailureNotFatal() {
+	guard := s.newGuard(false)
+	estimator := mock_budget.NewMockCostEstimator(gomock.NewController(s.T()))
+	guard.SetCostEstimator(estimator)
+	estimator.EXPECT().EstimateTransactionCost(&s.to, gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{1}).Return(big.NewInt(5), nil)
+	s.expectSpending(budget.HourPeriod, 2, 7)
+	s.expectSpending(budget.DayPeriod, 2, 7)
+
+	_, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_PastWindowsPrunedOnNewWindow() {
+	guard := s.newGuard(false)
+	s.mockTransactor.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{1}).Return(big.NewInt(5), nil)
+	var hour, day int64
+	s.mockSpendingStore.EXPECT().GetSpending(uint8(1), budget.HourPeriod, gomock.Any()).Return(big.NewInt(0), nil)
+	s.mockSpendingStore.EXPECT().StoreSpending(uint8(1), budget.HourPeriod, gomock.Any(), big.NewInt(5)).DoAndReturn(
+		func(_ uint8, _ string, window int64, _ *big.Int) error {
+			hour = window
+			return nil
+		})
+	s.mockSpendingStore.EXPECT().PruneSpending(uint8(1), budget.HourPeriod, gomock.Any()).DoAndReturn(
+		func(_ uint8, _ string, before int64) error {
+			s.Equal(hour-24, before)
+			return nil
+		})
+	s.mockSpendingStore.EXPECT().GetSpending(uint8(1), budget.DayPeriod, gomock.Any()).Return(big.NewInt(0), nil)
+	s.mockSpendingStore.EXPECT().StoreSpending(uint8(1), budget.DayPeriod, gomock.Any(), big.NewInt(5)).DoAndReturn(
+		func(_ uint8, _ string, window int64, _ *big.Int) error {
+			day = window
+			return nil
+		})
+	s.mockSpendingStore.EXPECT().PruneSpending(uint8(1), budget.DayPeriod, gomock.Any()).DoAndReturn(
+		func(_ uint8, _ string, before int64) error {
+			s.Equal(day-1, before)
+			return nil
+		})
+
+	_, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Nil(err)
+}
+
+func (s *SpendingGuardTestSuite) TestTransact_CancellationCostRecorded() {
+	handle := transactor.NewTxHandle(common.Hash{1}, 1)
+	handle.Resolve(&transactor.TxResult{
+		Status:  transactor.TxDropped,
+		Receipt: &types.Receipt{TxHash: common.Hash{2}},
+		Err:     errors.New("cancelled"),
+	})
+	guard := s.newAsyncGuard(handle)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{2}).Return(big.NewInt(5), nil)
+	s.expectSpending(budget.HourPeriod, 2, 7)
+	s.expectSpending(budget.DayPeriod, 2, 7)
+
+	_, err := guard.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+}
+
+func (s *SpendingGuardTestSuite) TestTransactAsync_CancellationCostRecorded() {
+	handle := transactor.NewTxHandle(common.Hash{1}, 1)
+	guard := s.newAsyncGuard(handle)
+	s.mockCostClient.EXPECT().TransactionCost(gomock.Any(), common.Hash{2}).Return(big.NewInt(5), nil)
+	s.expectSpending(budget.HourPeriod, 2, 7)
+	s.expectSpending(budget.DayPeriod, 2, 7)
+
+	_, err := guard.TransactAsync(&s.to, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+	handle.Resolve(&transactor.TxResult{
+		Status:  transactor.TxDropped,
+		Receipt: &types.Receipt{TxHash: common.Hash{2}},
+		Err:     errors.New("cancelled"),
+	})
+}
+
+func (s *SpendingGuardTestSuite) TestTransactAsync_DroppedWithoutReceiptNotRecorded() {
+	handle := transactor.NewTxHandle(common.Hash{1}, 1)
+	guard := s.newAsyncGuard(handle)
+
+	_, err := guard.TransactAsync(&s.to, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+	handle.Resolve(&transactor.TxResult{
+		Status: transactor.TxDropped,
+		Err:    errors.New("dropped"),
+	})
+}
@@ -0,0 +1,222 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/calls/transactor/budget/budget.go
+
+// Package mock_budget is a generated GoMock package.
+package mock_budget
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	budget "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/budget"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCostClient is a mock of CostClient interface.
+type MockCostClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCostClientMockRecorder
+}
+
+// MockCostClientMockRecorder is the mock recorder for MockCostClient.
+type MockCostClientMockRecorder struct {
+	mock *MockCostClient
+}
+
+// NewMockCostClient creates a new mock instance.
+func NewMockCostClient(ctrl *gomock.Controller) *MockCostClient {
+	mock := &MockCostClient{ctrl: ctrl}
+	mock.recorder = &MockCostClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCostClient) EXPECT() *MockCostClientMockRecorder {
+	return m.recorder
+}
+
+// TransactionCost mocks base method.
+func (m *MockCostClient) TransactionCost(ctx context.Context, txHash common.Hash) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionCost", ctx, txHash)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionCost indicates an expected call of TransactionCost.
+func (mr *MockCostClientMockRecorder) TransactionCost(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionCost", reflect.TypeOf((*MockCostClient)(nil).TransactionCost), ctx, txHash)
+}
+
+// MockCostEstimator is a mock of CostEstimator interface.
+type MockCostEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCostEstimatorMockRecorder
+}
+
+// MockCostEstimatorMockRecorder is the mock recorder for MockCostEstimator.
+type MockCostEstimatorMockRecorder struct {
+	mock *MockCostEstimator
+}
+
+// NewMockCostEstimator creates a new mock instance.
+func NewMockCostEstimator(ctrl *gomock.Controller) *MockCostEstimator {
+	mock := &MockCostEstimator{ctrl: ctrl}
+	mock.recorder = &MockCostEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCostEstimator) EXPECT() *MockCostEstimatorMockRecorder {
+	return m.recorder
+}
+
+// EstimateTransactionCost mocks base method.
+func (m *MockCostEstimator) EstimateTransactionCost(to *common.Address, data []byte, opts transactor.TransactOptions) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateTransactionCost", to, data, opts)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateTransactionCost indicates an expected call of EstimateTransactionCost.
+func (mr *MockCostEstimatorMockRecorder) EstimateTransactionCost(to, data, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateTransactionCost", reflect.TypeOf((*MockCostEstimator)(nil).EstimateTransactionCost), to, data, opts)
+}
+
+// MockSpendingStore is a mock of SpendingStore interface.
+type MockSpendingStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockSpendingStoreMockRecorder
+}
+
+// MockSpendingStoreMockRecorder is the mock recorder for MockSpendingStore.
+type MockSpendingStoreMockRecorder struct {
+	mock *MockSpendingStore
+}
+
+// NewMockSpendingStore creates a new mock instance.
+func NewMockSpendingStore(ctrl *gomock.Controller) *MockSpendingStore {
+	mock := &MockSpendingStore{ctrl: ctrl}
+	mock.recorder = &MockSpendingStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpendingStore) EXPECT() *MockSpendingStoreMockRecorder {
+	return m.recorder
+}
+
+// GetPaused mocks base method.
+func (m *MockSpendingStore) GetPaused(domainID uint8) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaused", domainID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaused indicates an expected call of GetPaused.
+func (mr *MockSpendingStoreMockRecorder) GetPaused(domainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaused", reflect.TypeOf((*MockSpendingStore)(nil).GetPaused), domainID)
+}
+
+// GetSpending mocks base method.
+func (m *MockSpendingStore) GetSpending(domainID uint8, period string, window int64) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpending", domainID, period, window)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpending indicates an expected call of GetSpending.
+func (mr *MockSpendingStoreMockRecorder) GetSpending(domainID, period, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpending", reflect.TypeOf((*MockSpendingStore)(nil).GetSpending), domainID, period, window)
+}
+
+// PruneSpending mocks base method.
+func (m *MockSpendingStore) PruneSpending(domainID uint8, period string, before int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneSpending", domainID, period, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneSpending indicates an expected call of PruneSpending.
+func (mr *MockSpendingStoreMockRecorder) PruneSpending(domainID, period, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneSpending", reflect.TypeOf((*MockSpendingStore)(nil).PruneSpending), domainID, period, before)
+}
+
+// StorePaused mocks base method.
+func (m *MockSpendingStore) StorePaused(domainID uint8, paused bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StorePaused", domainID, paused)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StorePaused indicates an expected call of StorePaused.
+func (mr *MockSpendingStoreMockRecorder) StorePaused(domainID, paused interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StorePaused", reflect.TypeOf((*MockSpendingStore)(nil).StorePaused), domainID, paused)
+}
+
+// StoreSpending mocks base method.
+func (m *MockSpendingStore) StoreSpending(domainID uint8, period string, window int64, spent *big.Int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreSpending", domainID, period, window, spent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreSpending indicates an expected call of StoreSpending.
+func (mr *MockSpendingStoreMockRecorder) StoreSpending(domainID, period, window, spent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreSpending", reflect.TypeOf((*MockSpendingStore)(nil).StoreSpending), domainID, period, window, spent)
+}
+
+// MockAlertReporter is a mock of AlertReporter interface.
+type MockAlertReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlertReporterMockRecorder
+}
+
+// MockAlertReporterMockRecorder is the mock recorder for MockAlertReporter.
+type MockAlertReporterMockRecorder struct {
+	mock *MockAlertReporter
+}
+
+// NewMockAlertReporter creates a new mock instance.
+func NewMockAlertReporter(ctrl *gomock.Controller) *MockAlertReporter {
+	mock := &MockAlertReporter{ctrl: ctrl}
+	mock.recorder = &MockAlertReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlertReporter) EXPECT() *MockAlertReporterMockRecorder {
+	return m.recorder
+}
+
+// Report mocks base method.
+func (m *MockAlertReporter) Report(alert *budget.Alert) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Report", alert)
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockAlertReporterMockRecorder) Report(alert interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockAlertReporter)(nil).Report), alert)
+}
@@ -0,0 +1,127 @@
+package transactor
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+type GasEstimateClient interface {
+	EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error)
+}
+
+// maxEstimateSamples is the number of the latest estimates of a method that are kept
+const maxEstimateSamples = 10
+
+// DefaultEstimateTTL is the time estimates are used as the lower bound of later estimates for
+var DefaultEstimateTTL = time.Hour
+
+type methodKey struct {
+	contract common.Address
+	method   [4]byte
+}
+
+type estimateSample struct {
+	gas uint64
+	at  time.Time
+}
+
+// GasLimitEstimator determines gas limit of transactions from node gas estimation.
+//
+// Highest of the recent estimates of a contract method is used as the lower bound of later
+// estimates of the method. Gas used by a method can depend on state at the time of mining,
+// e.g. vote that reaches the threshold also executes the proposal, so transaction estimated
+// before another relayer vote is mined could otherwise run out of gas. Only the last
+// maxEstimateSamples estimates not older than the estimate TTL are considered, so a single
+// expensive execution does not inflate gas limits of later transactions.
+type GasLimitEstimator struct {
+	client     GasEstimateClient
+	multiplier float64
+	ceiling    uint64
+	fallback   uint64
+	ttl        time.Duration
+
+	lock      sync.Mutex
+	estimates map[methodKey][]estimateSample
+}
+
+// NewGasLimitEstimator creates estimator that multiplies node estimates with the multiplier
+// and caps them to ceiling. Fallback gas limit is used when the method could not be estimated.
+func NewGasLimitEstimator(client GasEstimateClient, multiplier float64, ceiling uint64, fallback uint64) *GasLimitEstimator {
+	return &GasLimitEstimator{
+		client:     client,
+		multiplier: multiplier,
+		ceiling:    ceiling,
+		fallback:   fallback,
+		ttl:        DefaultEstimateTTL,
+		estimates:  make(map[methodKey][]estimateSample),
+	}
+}
+
+// SetEstimateTTL sets the time estimates are used as the lower bound of later estimates for
+func (e *GasLimitEstimator) SetEstimateTTL(ttl time.Duration) {
+	e.ttl = ttl
+}
+
+// EstimateGasLimit returns gas limit for the transaction
+func (e *GasLimitEstimator) EstimateGasLimit(from common.Address, to *common.Address, data []byte, value *big.Int) uint64 {
+	key := methodKey{}
+	if to != nil {
+		key.contract = *to
+	}
+	copy(key.method[:], data)
+
+	estimate, err := e.client.EstimateGas(context.Background(), ethereum.CallMsg{
+		From:  from,
+		To:    to,
+		Value: value,
+		Data:  data,
+	})
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	samples := e.recentEstimates(key)
+	if err != nil {
+		if len(samples) == 0 {
+			log.Warn().Err(err).Msgf("Failed estimating gas of transaction to %s, using fallback gas limit %d", to, e.fallback)
+			return e.fallback
+		}
+		log.Warn().Err(err).Msgf("Failed estimating gas of transaction to %s, using cached estimate", to)
+	} else {
+		samples = append(samples, estimateSample{gas: estimate, at: time.Now()})
+		if len(samples) > maxEstimateSamples {
+			samples = samples[len(samples)-maxEstimateSamples:]
+		}
+		e.estimates[key] = samples
+	}
+	for _, sample := range samples {
+		if sample.gas > estimate {
+			estimate = sample.gas
+		}
+	}
+
+	limit := uint64(float64(estimate) * e.multiplier)
+	if e.ceiling != 0 && limit > e.ceiling {
+		log.Warn().Msgf("Gas limit %d of transaction to %s is over ceiling %d", limit, to, e.ceiling)
+		limit = e.ceiling
+	}
+	return limit
+}
+
+// recentEstimates returns estimates of the method that have not expired
+func (e *GasLimitEstimator) recentEstimates(key methodKey) []estimateSample {
+	samples := e.estimates[key]
+	for len(samples) > 0 && time.Since(samples[0].at) >= e.ttl {
+		samples = samples[1:]
+	}
+	if len(samples) == 0 {
+		delete(e.estimates, key)
+	}
+	return samples
+}
@@ -0,0 +1,107 @@
+package transactor_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	mock_transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GasLimitEstimatorTestSuite struct {
+	suite.Suite
+	estimator  *transactor.GasLimitEstimator
+	mockClient *mock_transactor.MockGasEstimateClient
+	from       common.Address
+	to         common.Address
+}
+
+func TestRunGasLimitEstimatorTestSuite(t *testing.T) {
+	suite.Run(t, new(GasLimitEstimatorTestSuite))
+}
+
+func (s *GasLimitEstimatorTestSuite) SetupSuite()    {}
+func (s *GasLimitEstimatorTestSuite) TearDownSuite() {}
+func (s *GasLimitEstimatorTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_transactor.NewMockGasEstimateClient(gomockController)
+	s.estimator = transactor.NewGasLimitEstimator(s.mockClient, 1.5, 300000, 2000000)
+	s.from = common.HexToAddress("0xf")
+	s.to = common.HexToAddress("0x1")
+}
+func (s *GasLimitEstimatorTestSuite) TearDownTest() {}
+
+func (s *GasLimitEstimatorTestSuite) TestEstimateGasLimit_MultipliedEstimate() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(100000), nil)
+
+	limit := s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4, 5}, nil)
+
+	s.Equal(uint64(150000), limit)
+}
+
+func (s *GasLimitEstimatorTestSuite) TestEstimateGasLimit_CappedToCeiling() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(250000), nil)
+
+	limit := s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4, 5}, nil)
+
+	s.Equal(uint64(300000), limit)
+}
+
+func (s *GasLimitEstimatorTestSuite) TestEstimateGasLimit_FallbackOnFailure() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(0), errors.New("error"))
+
+	limit := s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4, 5}, nil)
+
+	s.Equal(uint64(2000000), limit)
+}
+
+func (s *GasLimitEstimatorTestSuite) TestEstimateGasLimit_CachedEstimateOnFailure() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(100000), nil)
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(0), errors.New("error"))
+
+	_ = s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4, 5}, nil)
+	limit := s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4, 6}, nil)
+
+	s.Equal(uint64(150000), limit)
+}
+
+func (s *GasLimitEstimatorTestSuite) TestEstimateGasLimit_CachedPerMethod() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(150000), nil)
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(50000), nil)
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(50000), nil)
+
+	_ = s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4}, nil)
+	// lower estimate of the same method is raised to the highest seen estimate
+	sameMethod := s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4, 5}, nil)
+	otherMethod := s.estimator.EstimateGasLimit(s.from, &s.to, []byte{4, 3, 2, 1}, nil)
+
+	s.Equal(uint64(225000), sameMethod)
+	s.Equal(uint64(75000), otherMethod)
+}
+
+func (s *GasLimitEstimatorTestSuite) TestEstimateGasLimit_ExpensiveEstimateLeavesWindow() {
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(150000), nil)
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Times(10).Return(uint64(50000), nil)
+
+	_ = s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4}, nil)
+	var limit uint64
+	for i := 0; i < 10; i++ {
+		limit = s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4}, nil)
+	}
+
+	s.Equal(uint64(75000), limit)
+}
+
+func (s *GasLimitEstimatorTestSuite) TestEstimateGasLimit_ExpiredEstimateNotUsed() {
+	s.estimator.SetEstimateTTL(0)
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(150000), nil)
+	s.mockClient.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(50000), nil)
+
+	_ = s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4}, nil)
+	limit := s.estimator.EstimateGasLimit(s.from, &s.to, []byte{1, 2, 3, 4}, nil)
+
+	s.Equal(uint64(75000), limit)
+}
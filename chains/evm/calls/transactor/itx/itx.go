@@ -60,10 +60,15 @@ type Signer interface {
 	Sign(digestHash []byte) ([]byte, error)
 }
 
+type GasLimitEstimator interface {
+	EstimateGasLimit(from common.Address, to *common.Address, data []byte, value *big.Int) uint64
+}
+
 type ITXTransactor struct {
 	forwarder   Forwarder
 	relayCaller RelayCaller
 	signer      Signer
+	estimator   GasLimitEstimator
 }
 
 func NewITXTransactor(relayCaller RelayCaller, forwarder Forwarder, signer Signer) *ITXTransactor {
@@ -74,8 +79,16 @@ func NewITXTransactor(relayCaller RelayCaller, forwarder Forwarder, signer Signe
 	}
 }
 
+// SetGasLimitEstimator makes transactor estimate gas limit of transactions sent without one
+func (itx *ITXTransactor) SetGasLimitEstimator(estimator GasLimitEstimator) {
+	itx.estimator = estimator
+}
+
 // Transact packs tx into a forwarded transaction, signs it and sends the relayed transaction to Infura ITX
 func (itx *ITXTransactor) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+	if opts.GasLimit == 0 && itx.estimator != nil {
+		opts.GasLimit = itx.estimator.EstimateGasLimit(itx.signer.CommonAddress(), to, data, opts.Value)
+	}
 	err := transactor.MergeTransactionOptions(&opts, &DefaultTransactionOptions)
 	if err != nil {
 		return nil, err
@@ -224,3 +224,40 @@ func (mr *MockSignerMockRecorder) Sign(digestHash interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockSigner)(nil).Sign), digestHash)
 }
+
+// MockGasLimitEstimator is a mock of GasLimitEstimator interface.
+type MockGasLimitEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockGasLimitEstimatorMockRecorder
+}
+
+// MockGasLimitEstimatorMockRecorder is the mock recorder for MockGasLimitEstimator.
+type MockGasLimitEstimatorMockRecorder struct {
+	mock *MockGasLimitEstimator
+}
+
+// NewMockGasLimitEstimator creates a new mock instance.
+func NewMockGasLimitEstimator(ctrl *gomock.Controller) *MockGasLimitEstimator {
+	mock := &MockGasLimitEstimator{ctrl: ctrl}
+	mock.recorder = &MockGasLimitEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGasLimitEstimator) EXPECT() *MockGasLimitEstimatorMockRecorder {
+	return m.recorder
+}
+
+// EstimateGasLimit mocks base method.
+func (m *MockGasLimitEstimator) EstimateGasLimit(from common.Address, to *common.Address, data []byte, value *big.Int) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGasLimit", from, to, data, value)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// EstimateGasLimit indicates an expected call of EstimateGasLimit.
+func (mr *MockGasLimitEstimatorMockRecorder) EstimateGasLimit(from, to, data, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGasLimit", reflect.TypeOf((*MockGasLimitEstimator)(nil).EstimateGasLimit), from, to, data, value)
+}
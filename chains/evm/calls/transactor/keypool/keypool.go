@@ -0,0 +1,133 @@
+package keypool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+var ErrNoSenders = errors.New("key pool requires at least one sender")
+
+type BalanceClient interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+type RelayerChecker interface {
+	IsRelayer(relayerAddress common.Address) (bool, error)
+}
+
+// Sender is a key of the pool with the transactor that sends transactions signed by it
+type Sender struct {
+	Address    common.Address
+	Transactor transactor.Transactor
+}
+
+// KeyPool is a transactor that sends transactions in parallel with multiple sender keys.
+// Every transaction is dispatched to the first key that is not busy sending
+// another transaction, so nonce of each key is used by one transaction at a time.
+type KeyPool struct {
+	senders []Sender
+	client  BalanceClient
+	free    chan int
+}
+
+func NewKeyPool(senders []Sender, client BalanceClient) (*KeyPool, error) {
+	if len(senders) == 0 {
+		return nil, ErrNoSenders
+	}
+
+	free := make(chan int, len(senders))
+	for i := range senders {
+		free <- i
+	}
+	return &KeyPool{
+		senders: senders,
+		client:  client,
+		free:    free,
+	}, nil
+}
+
+// Transact sends transaction with the first free key and blocks until the key
+// transactor returns. Nonce from options is ignored as it is tracked per key.
+func (p *KeyPool) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+	i := <-p.free
+	defer p.release(i)
+
+	opts.Nonce = nil
+	log.Debug().Msgf("Sending transaction to %s with pool key %s", to, p.senders[i].Address)
+	return p.senders[i].Transactor.Transact(to, data, opts)
+}
+
+// TransactAsync sends transaction with the first free key. Key is released as soon as
+// transaction is broadcast. All of the pool key transactors have to support asynchronous transactions.
+func (p *KeyPool) TransactAsync(to *common.Address, data []byte, opts transactor.TransactOptions) (*transactor.TxHandle, error) {
+	i := <-p.free
+	defer p.release(i)
+
+	t, ok := p.senders[i].Transactor.(transactor.AsyncTransactor)
+	if !ok {
+		return nil, transactor.ErrAsyncNotSupported
+	}
+	opts.Nonce = nil
+	log.Debug().Msgf("Sending transaction to %s with pool key %s", to, p.senders[i].Address)
+	return t.TransactAsync(to, data, opts)
+}
+
+func (p *KeyPool) release(i int) {
+	p.free <- i
+}
+
+// Addresses returns addresses of all pool keys
+func (p *KeyPool) Addresses() []common.Address {
+	addresses := make([]common.Address, len(p.senders))
+	for i, s := range p.senders {
+		addresses[i] = s.Address
+	}
+	return addresses
+}
+
+// Balances returns latest balance of every pool key
+func (p *KeyPool) Balances(ctx context.Context) (map[common.Address]*big.Int, error) {
+	balances := make(map[common.Address]*big.Int, len(p.senders))
+	for _, s := range p.senders {
+		balance, err := p.client.BalanceAt(ctx, s.Address, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed fetching balance of %s: %w", s.Address, err)
+		}
+		balances[s.Address] = balance
+	}
+	return balances, nil
+}
+
+// LogBalances logs latest balance of every pool key
+func (p *KeyPool) LogBalances(ctx context.Context) {
+	balances, err := p.Balances(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed fetching pool key balances")
+		return
+	}
+	for _, s := range p.senders {
+		log.Info().Str("key", s.Address.String()).Msgf("Pool key balance: %s", balances[s.Address])
+	}
+}
+
+// UnregisteredKeys returns pool keys that are not registered as relayers on the bridge.
+// Votes sent with those keys are rejected by the bridge.
+func (p *KeyPool) UnregisteredKeys(checker RelayerChecker) ([]common.Address, error) {
+	unregistered := make([]common.Address, 0)
+	for _, s := range p.senders {
+		isRelayer, err := checker.IsRelayer(s.Address)
+		if err != nil {
+			return nil, err
+		}
+		if !isRelayer {
+			unregistered = append(unregistered, s.Address)
+		}
+	}
+	return unregistered, nil
+}
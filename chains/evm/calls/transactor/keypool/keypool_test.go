@@ -0,0 +1,126 @@
+package keypool_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/keypool"
+	mock_keypool "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/keypool/mock"
+	mock_transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type KeyPoolTestSuite struct {
+	suite.Suite
+	pool               *keypool.KeyPool
+	mockTransactorA    *mock_transactor.MockTransactor
+	mockTransactorB    *mock_transactor.MockTransactor
+	mockBalanceClient  *mock_keypool.MockBalanceClient
+	mockRelayerChecker *mock_keypool.MockRelayerChecker
+	to                 common.Address
+}
+
+func TestRunKeyPoolTestSuite(t *testing.T) {
+	suite.Run(t, new(KeyPoolTestSuite))
+}
+
+func (s *KeyPoolTestSuite) SetupSuite()    {}
+func (s *KeyPoolTestSuite) TearDownSuite() {}
+func (s *KeyPoolTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockTransactorA = mock_transactor.NewMockTransactor(gomockController)
+	s.mockTransactorB = mock_transactor.NewMockTransactor(gomockController)
+	s.mockBalanceClient = mock_keypool.NewMockBalanceClient(gomockController)
+	s.mockRelayerChecker = mock_keypool.NewMockRelayerChecker(gomockController)
+	s.pool, _ = keypool.NewKeyPool([]keypool.Sender{
+		{Address: common.Address{1}, Transactor: s.mockTransactorA},
+		{Address: common.Address{2}, Transactor: s.mockTransactorB},
+	}, s.mockBalanceClient)
+	s.to = common.HexToAddress("0x04005C8A516292af163b1AFe3D855b9f4f4631B5")
+}
+func (s *KeyPoolTestSuite) TearDownTest() {}
+
+func (s *KeyPoolTestSuite) TestNewKeyPool_NoSenders() {
+	_, err := keypool.NewKeyPool([]keypool.Sender{}, s.mockBalanceClient)
+
+	s.Equal(keypool.ErrNoSenders, err)
+}
+
+func (s *KeyPoolTestSuite) TestTransact_DispatchedToFreeKey() {
+	sending := make(chan struct{})
+	mined := make(chan struct{})
+	s.mockTransactorA.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+			close(sending)
+			<-mined
+			return &common.Hash{1}, nil
+		})
+	s.mockTransactorB.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil)
+
+	go func() {
+		_, _ = s.pool.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+	}()
+	<-sending
+	hash, err := s.pool.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+	close(mined)
+
+	s.Nil(err)
+	s.Equal(&common.Hash{2}, hash)
+}
+
+func (s *KeyPoolTestSuite) TestTransact_KeyReleasedAfterError() {
+	s.mockTransactorA.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+	s.mockTransactorB.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{2}, nil)
+	s.mockTransactorA.EXPECT().Transact(&s.to, gomock.Any(), gomock.Any()).Return(&common.Hash{1}, nil)
+
+	_, err := s.pool.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+	s.NotNil(err)
+	_, err = s.pool.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+	hash, err := s.pool.Transact(&s.to, []byte{}, transactor.TransactOptions{})
+	s.Nil(err)
+	s.Equal(&common.Hash{1}, hash)
+}
+
+func (s *KeyPoolTestSuite) TestTransactAsync_NotSupported() {
+	_, err := s.pool.TransactAsync(&s.to, []byte{}, transactor.TransactOptions{})
+
+	s.Equal(transactor.ErrAsyncNotSupported, err)
+}
+
+func (s *KeyPoolTestSuite) TestBalances() {
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.Address{1}, nil).Return(big.NewInt(10), nil)
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.Address{2}, nil).Return(big.NewInt(20), nil)
+
+	balances, err := s.pool.Balances(context.Background())
+
+	s.Nil(err)
+	s.Equal(map[common.Address]*big.Int{
+		{1}: big.NewInt(10),
+		{2}: big.NewInt(20),
+	}, balances)
+}
+
+func (s *KeyPoolTestSuite) TestBalances_FetchingFails() {
+	s.mockBalanceClient.EXPECT().BalanceAt(gomock.Any(), common.Address{1}, nil).Return(nil, errors.New("error"))
+
+	_, err := s.pool.Balances(context.Background())
+
+	s.NotNil(err)
+}
+
+func (s *KeyPoolTestSuite) TestUnregisteredKeys() {
+	s.mockRelayerChecker.EXPECT().IsRelayer(common.Address{1}).Return(true, nil)
+	s.mockRelayerChecker.EXPECT().IsRelayer(common.Address{2}).Return(false, nil)
+
+	unregistered, err := s.pool.UnregisteredKeys(s.mockRelayerChecker)
+
+	s.Nil(err)
+	s.Equal([]common.Address{{2}}, unregistered)
+	s.Equal([]common.Address{{1}, {2}}, s.pool.Addresses())
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/calls/transactor/keypool/keypool.go
+
+// Package mock_keypool is a generated GoMock package.
+package mock_keypool
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBalanceClient is a mock of BalanceClient interface.
+type MockBalanceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBalanceClientMockRecorder
+}
+
+// MockBalanceClientMockRecorder is the mock recorder for MockBalanceClient.
+type MockBalanceClientMockRecorder struct {
+	mock *MockBalanceClient
+}
+
+// NewMockBalanceClient creates a new mock instance.
+func NewMockBalanceClient(ctrl *gomock.Controller) *MockBalanceClient {
+	mock := &MockBalanceClient{ctrl: ctrl}
+	mock.recorder = &MockBalanceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBalanceClient) EXPECT() *MockBalanceClientMockRecorder {
+	return m.recorder
+}
+
+// BalanceAt mocks base method.
+func (m *MockBalanceClient) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BalanceAt", ctx, account, blockNumber)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BalanceAt indicates an expected call of BalanceAt.
+func (mr *MockBalanceClientMockRecorder) BalanceAt(ctx, account, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BalanceAt", reflect.TypeOf((*MockBalanceClient)(nil).BalanceAt), ctx, account, blockNumber)
+}
+
+// MockRelayerChecker is a mock of RelayerChecker interface.
+type MockRelayerChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelayerCheckerMockRecorder
+}
+
+// MockRelayerCheckerMockRecorder is the mock recorder for MockRelayerChecker.
+type MockRelayerCheckerMockRecorder struct {
+	mock *MockRelayerChecker
+}
+
+// NewMockRelayerChecker creates a new mock instance.
+func NewMockRelayerChecker(ctrl *gomock.Controller) *MockRelayerChecker {
+	mock := &MockRelayerChecker{ctrl: ctrl}
+	mock.recorder = &MockRelayerCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelayerChecker) EXPECT() *MockRelayerCheckerMockRecorder {
+	return m.recorder
+}
+
+// IsRelayer mocks base method.
+func (m *MockRelayerChecker) IsRelayer(relayerAddress common.Address) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRelayer", relayerAddress)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRelayer indicates an expected call of IsRelayer.
+func (mr *MockRelayerCheckerMockRecorder) IsRelayer(relayerAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRelayer", reflect.TypeOf((*MockRelayerChecker)(nil).IsRelayer), relayerAddress)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/calls/transactor/estimate.go
+
+// Package mock_transactor is a generated GoMock package.
+package mock_transactor
+
+import (
+	context "context"
+	reflect "reflect"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGasEstimateClient is a mock of GasEstimateClient interface.
+type MockGasEstimateClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockGasEstimateClientMockRecorder
+}
+
+// MockGasEstimateClientMockRecorder is the mock recorder for MockGasEstimateClient.
+type MockGasEstimateClientMockRecorder struct {
+	mock *MockGasEstimateClient
+}
+
+// NewMockGasEstimateClient creates a new mock instance.
+func NewMockGasEstimateClient(ctrl *gomock.Controller) *MockGasEstimateClient {
+	mock := &MockGasEstimateClient{ctrl: ctrl}
+	mock.recorder = &MockGasEstimateClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGasEstimateClient) EXPECT() *MockGasEstimateClientMockRecorder {
+	return m.recorder
+}
+
+// EstimateGas mocks base method.
+func (m *MockGasEstimateClient) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", ctx, msg)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockGasEstimateClientMockRecorder) EstimateGas(ctx, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockGasEstimateClient)(nil).EstimateGas), ctx, msg)
+}
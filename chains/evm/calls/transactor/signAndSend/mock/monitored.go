@@ -0,0 +1,307 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/calls/transactor/signAndSend/monitored.go
+
+// Package mock_signAndSend is a generated GoMock package.
+package mock_signAndSend
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	evmclient "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMonitoredClient is a mock of MonitoredClient interface.
+type MockMonitoredClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockMonitoredClientMockRecorder
+}
+
+// MockMonitoredClientMockRecorder is the mock recorder for MockMonitoredClient.
+type MockMonitoredClientMockRecorder struct {
+	mock *MockMonitoredClient
+}
+
+// NewMockMonitoredClient creates a new mock instance.
+func NewMockMonitoredClient(ctrl *gomock.Controller) *MockMonitoredClient {
+	mock := &MockMonitoredClient{ctrl: ctrl}
+	mock.recorder = &MockMonitoredClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMonitoredClient) EXPECT() *MockMonitoredClientMockRecorder {
+	return m.recorder
+}
+
+// From mocks base method.
+func (m *MockMonitoredClient) From() common.Address {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "From")
+	ret0, _ := ret[0].(common.Address)
+	return ret0
+}
+
+// From indicates an expected call of From.
+func (mr *MockMonitoredClientMockRecorder) From() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "From", reflect.TypeOf((*MockMonitoredClient)(nil).From))
+}
+
+// GetTransactionByHash mocks base method.
+func (m *MockMonitoredClient) GetTransactionByHash(h common.Hash) (*types.Transaction, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByHash", h)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTransactionByHash indicates an expected call of GetTransactionByHash.
+func (mr *MockMonitoredClientMockRecorder) GetTransactionByHash(h interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByHash", reflect.TypeOf((*MockMonitoredClient)(nil).GetTransactionByHash), h)
+}
+
+// LockNonce mocks base method.
+func (m *MockMonitoredClient) LockNonce() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LockNonce")
+}
+
+// LockNonce indicates an expected call of LockNonce.
+func (mr *MockMonitoredClientMockRecorder) LockNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockNonce", reflect.TypeOf((*MockMonitoredClient)(nil).LockNonce))
+}
+
+// NonceAt mocks base method.
+func (m *MockMonitoredClient) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NonceAt", ctx, account, blockNumber)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NonceAt indicates an expected call of NonceAt.
+func (mr *MockMonitoredClientMockRecorder) NonceAt(ctx, account, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NonceAt", reflect.TypeOf((*MockMonitoredClient)(nil).NonceAt), ctx, account, blockNumber)
+}
+
+// SignAndSendTransaction mocks base method.
+func (m *MockMonitoredClient) SignAndSendTransaction(ctx context.Context, tx evmclient.CommonTransaction) (common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignAndSendTransaction", ctx, tx)
+	ret0, _ := ret[0].(common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignAndSendTransaction indicates an expected call of SignAndSendTransaction.
+func (mr *MockMonitoredClientMockRecorder) SignAndSendTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignAndSendTransaction", reflect.TypeOf((*MockMonitoredClient)(nil).SignAndSendTransaction), ctx, tx)
+}
+
+// SignTransaction mocks base method.
+func (m *MockMonitoredClient) SignTransaction(ctx context.Context, tx evmclient.CommonTransaction) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTransaction", ctx, tx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignTransaction indicates an expected call of SignTransaction.
+func (mr *MockMonitoredClientMockRecorder) SignTransaction(ctx, tx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTransaction", reflect.TypeOf((*MockMonitoredClient)(nil).SignTransaction), ctx, tx)
+}
+
+// TransactionReceipt mocks base method.
+func (m *MockMonitoredClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", ctx, txHash)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockMonitoredClientMockRecorder) TransactionReceipt(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockMonitoredClient)(nil).TransactionReceipt), ctx, txHash)
+}
+
+// UnlockNonce mocks base method.
+func (m *MockMonitoredClient) UnlockNonce() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UnlockNonce")
+}
+
+// UnlockNonce indicates an expected call of UnlockNonce.
+func (mr *MockMonitoredClientMockRecorder) UnlockNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockNonce", reflect.TypeOf((*MockMonitoredClient)(nil).UnlockNonce))
+}
+
+// UnsafeIncreaseNonce mocks base method.
+func (m *MockMonitoredClient) UnsafeIncreaseNonce() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsafeIncreaseNonce")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnsafeIncreaseNonce indicates an expected call of UnsafeIncreaseNonce.
+func (mr *MockMonitoredClientMockRecorder) UnsafeIncreaseNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeIncreaseNonce", reflect.TypeOf((*MockMonitoredClient)(nil).UnsafeIncreaseNonce))
+}
+
+// UnsafeNonce mocks base method.
+func (m *MockMonitoredClient) UnsafeNonce() (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnsafeNonce")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnsafeNonce indicates an expected call of UnsafeNonce.
+func (mr *MockMonitoredClientMockRecorder) UnsafeNonce() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsafeNonce", reflect.TypeOf((*MockMonitoredClient)(nil).UnsafeNonce))
+}
+
+// WaitAndReturnTxReceipt mocks base method.
+func (m *MockMonitoredClient) WaitAndReturnTxReceipt(h common.Hash) (*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitAndReturnTxReceipt", h)
+	ret0, _ := ret[0].(*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitAndReturnTxReceipt indicates an expected call of WaitAndReturnTxReceipt.
+func (mr *MockMonitoredClientMockRecorder) WaitAndReturnTxReceipt(h interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitAndReturnTxReceipt", reflect.TypeOf((*MockMonitoredClient)(nil).WaitAndReturnTxReceipt), h)
+}
+
+// MockGasLimitEstimator is a mock of GasLimitEstimator interface.
+type MockGasLimitEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockGasLimitEstimatorMockRecorder
+}
+
+// MockGasLimitEstimatorMockRecorder is the mock recorder for MockGasLimitEstimator.
+type MockGasLimitEstimatorMockRecorder struct {
+	mock *MockGasLimitEstimator
+}
+
+// NewMockGasLimitEstimator creates a new mock instance.
+func NewMockGasLimitEstimator(ctrl *gomock.Controller) *MockGasLimitEstimator {
+	mock := &MockGasLimitEstimator{ctrl: ctrl}
+	mock.recorder = &MockGasLimitEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGasLimitEstimator) EXPECT() *MockGasLimitEstimatorMockRecorder {
+	return m.recorder
+}
+
+// EstimateGasLimit mocks base method.
+func (m *MockGasLimitEstimator) EstimateGasLimit(from common.Address, to *common.Address, data []byte, value *big.Int) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGasLimit", from, to, data, value)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// EstimateGasLimit indicates an expected call of EstimateGasLimit.
+func (mr *MockGasLimitEstimatorMockRecorder) EstimateGasLimit(from, to, data, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGasLimit", reflect.TypeOf((*MockGasLimitEstimator)(nil).EstimateGasLimit), from, to, data, value)
+}
+
+// MockCostEstimator is a mock of CostEstimator interface.
+type MockCostEstimator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCostEstimatorMockRecorder
+}
+
+// MockCostEstimatorMockRecorder is the mock recorder for MockCostEstimator.
+type MockCostEstimatorMockRecorder struct {
+	mock *MockCostEstimator
+}
+
+// NewMockCostEstimator creates a new mock instance.
+func NewMockCostEstimator(ctrl *gomock.Controller) *MockCostEstimator {
+	mock := &MockCostEstimator{ctrl: ctrl}
+	mock.recorder = &MockCostEstimatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCostEstimator) EXPECT() *MockCostEstimatorMockRecorder {
+	return m.recorder
+}
+
+// EstimateCost mocks base method.
+func (m *MockCostEstimator) EstimateCost(gasLimit uint64, gasPrices []*big.Int, rawTx []byte) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateCost", gasLimit, gasPrices, rawTx)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateCost indicates an expected call of EstimateCost.
+func (mr *MockCostEstimatorMockRecorder) EstimateCost(gasLimit, gasPrices, rawTx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateCost", reflect.TypeOf((*MockCostEstimator)(nil).EstimateCost), gasLimit, gasPrices, rawTx)
+}
+
+// MockCostTracker is a mock of CostTracker interface.
+type MockCostTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCostTrackerMockRecorder
+}
+
+// MockCostTrackerMockRecorder is the mock recorder for MockCostTracker.
+type MockCostTrackerMockRecorder struct {
+	mock *MockCostTracker
+}
+
+// NewMockCostTracker creates a new mock instance.
+func NewMockCostTracker(ctrl *gomock.Controller) *MockCostTracker {
+	mock := &MockCostTracker{ctrl: ctrl}
+	mock.recorder = &MockCostTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCostTracker) EXPECT() *MockCostTrackerMockRecorder {
+	return m.recorder
+}
+
+// TrackTransactionCost mocks base method.
+func (m *MockCostTracker) TrackTransactionCost(domainID uint8, cost *big.Int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "TrackTransactionCost", domainID, cost)
+}
+
+// TrackTransactionCost indicates an expected call of TrackTransactionCost.
+func (mr *MockCostTrackerMockRecorder) TrackTransactionCost(domainID, cost interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackTransactionCost", reflect.TypeOf((*MockCostTracker)(nil).TrackTransactionCost), domainID, cost)
+}
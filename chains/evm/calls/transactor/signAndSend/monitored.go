@@ -0,0 +1,545 @@
+package signAndSend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// MinFeeBumpPercent is the minimal fee increase nodes accept for replacement transactions
+	MinFeeBumpPercent = 10
+	cancelGasLimit    = 21000
+)
+
+var ErrNotTracked = errors.New("no pending transaction with nonce")
+var ErrNonceUsed = errors.New("nonce used by transaction not sent by transactor")
+
+type MonitoredClient interface {
+	calls.ClientDispatcher
+	SignTransaction(ctx context.Context, tx evmclient.CommonTransaction) ([]byte, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+}
+
+// MonitorOpts configures replacement of transactions that are not mined in time
+type MonitorOpts struct {
+	// ResubmitTimeout is the time after which transaction is re-submitted with bumped fees
+	ResubmitTimeout time.Duration
+	// MaxResubmits is the number of replacements after which transaction is cancelled
+	MaxResubmits int
+	// FeeBumpPercent is the percentage fees are increased by on each replacement. Values lower than MinFeeBumpPercent are raised to it.
+	FeeBumpPercent int64
+	// UpperLimitFeePerGas limits gas price or fee cap of replacements. If nil - not applied
+	UpperLimitFeePerGas *big.Int
+	// ReceiptPollInterval is the interval between receipt checks of sent transactions
+	ReceiptPollInterval time.Duration
+	// TrackTimeout is the time after which transaction that is still not mined is dropped. If zero - DefaultMonitorOpts value is used
+	TrackTimeout time.Duration
+}
+
+var DefaultMonitorOpts = MonitorOpts{
+	ResubmitTimeout:     3 * time.Minute,
+	MaxResubmits:        5,
+	FeeBumpPercent:      MinFeeBumpPercent,
+	ReceiptPollInterval: 5 * time.Second,
+	TrackTimeout:        time.Hour,
+}
+
+// sentTx holds everything needed to rebuild transaction sent with the nonce
+type sentTx struct {
+	nonce     uint64
+	to        *common.Address
+	value     *big.Int
+	gasLimit  uint64
+	gasPrices []*big.Int
+	data      []byte
+	priority  uint8
+	obsolete  func() bool
+	hashes    []common.Hash
+	// cancels are hashes of transactions cancelling the original one
+	cancels map[common.Hash]bool
+	sentAt  time.Time
+}
+
+type GasLimitEstimator interface {
+	EstimateGasLimit(from common.Address, to *common.Address, data []byte, value *big.Int) uint64
+}
+
+// CostEstimator estimates total cost of the RLP-serialized signed transaction
+type CostEstimator interface {
+	EstimateCost(gasLimit uint64, gasPrices []*big.Int, rawTx []byte) (*big.Int, error)
+}
+
+type CostTracker interface {
+	TrackTransactionCost(domainID uint8, cost *big.Int)
+}
+
+// MonitoredTransactor is a sign and send transactor that tracks sent transactions by nonce and
+// replaces those that are not mined in time with the same transaction with bumped fees.
+// Transactions that are still not mined after MaxResubmits replacements, or are no longer needed, are cancelled.
+// Transactions can be sent either synchronously with Transact or asynchronously with TransactAsync.
+type MonitoredTransactor struct {
+	txFabric       calls.TxFabric
+	gasPriceClient calls.GasPricer
+	client         MonitoredClient
+	opts           MonitorOpts
+	estimator      GasLimitEstimator
+	costEstimator  CostEstimator
+	costTracker    CostTracker
+	domainID       uint8
+
+	lock    sync.Mutex
+	pending map[uint64]*sentTx
+}
+
+func NewMonitoredTransactor(txFabric calls.TxFabric, gasPriceClient calls.GasPricer, client MonitoredClient, opts MonitorOpts) *MonitoredTransactor {
+	if opts.FeeBumpPercent < MinFeeBumpPercent {
+		opts.FeeBumpPercent = MinFeeBumpPercent
+	}
+	if opts.TrackTimeout == 0 {
+		opts.TrackTimeout = DefaultMonitorOpts.TrackTimeout
+	}
+	return &MonitoredTransactor{
+		txFabric:       txFabric,
+		gasPriceClient: gasPriceClient,
+		client:         client,
+		opts:           opts,
+		pending:        make(map[uint64]*sentTx),
+	}
+}
+
+// SetGasLimitEstimator makes transactor estimate gas limit of transactions sent without one
+func (t *MonitoredTransactor) SetGasLimitEstimator(estimator GasLimitEstimator) {
+	t.estimator = estimator
+}
+
+// SetCostTracking makes transactor estimate total cost of every sent transaction and
+// report it to the tracker
+func (t *MonitoredTransactor) SetCostTracking(domainID uint8, estimator CostEstimator, tracker CostTracker) {
+	t.domainID = domainID
+	t.costEstimator = estimator
+	t.costTracker = tracker
+}
+
+// Transact sends transaction and waits until it, or one of its replacements, is mined
+func (t *MonitoredTransactor) Transact(to *common.Address, data []byte, opts transactor.TransactOptions) (*common.Hash, error) {
+	handle, err := t.TransactAsync(to, data, opts)
+	if err != nil {
+		return &common.Hash{}, err
+	}
+
+	result := handle.Wait()
+	switch result.Status {
+	case transactor.TxMined:
+		return &result.Receipt.TxHash, nil
+	case transactor.TxReverted:
+		return &common.Hash{}, &evmclient.TransactionFailedError{Receipt: result.Receipt}
+	default:
+		return &common.Hash{}, result.Err
+	}
+}
+
+// TransactAsync sends transaction and returns handle that is resolved in the background once
+// the transaction, or one of its replacements, is mined or the transaction is cancelled
+func (t *MonitoredTransactor) TransactAsync(to *common.Address, data []byte, opts transactor.TransactOptions) (*transactor.TxHandle, error) {
+	gp, err := t.prepare(to, data, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := t.send(to, opts.Value, opts.GasLimit, gp, data, opts.Priority)
+	if err != nil {
+		return nil, err
+	}
+	tx.obsolete = opts.Obsolete
+	if t.costEstimator != nil {
+		cost, err := t.estimateCost(tx.nonce, to, opts.Value, opts.GasLimit, gp, data)
+		if err != nil {
+			log.Warn().Err(err).Msg("Failed estimating transaction cost")
+		} else if t.costTracker != nil {
+			t.costTracker.TrackTransactionCost(t.domainID, cost)
+		}
+	}
+
+	handle := transactor.NewTxHandle(tx.hashes[0], tx.nonce)
+	go t.track(tx, handle)
+	return handle, nil
+}
+
+// EstimateTransactionCost estimates total cost of the transaction, including L1 data fee on rollups,
+// without sending it
+func (t *MonitoredTransactor) EstimateTransactionCost(to *common.Address, data []byte, opts transactor.TransactOptions) (*big.Int, error) {
+	if t.costEstimator == nil {
+		return nil, errors.New("transaction cost estimation not configured")
+	}
+	gp, err := t.prepare(to, data, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	t.client.LockNonce()
+	n, err := t.client.UnsafeNonce()
+	t.client.UnlockNonce()
+	if err != nil {
+		return nil, err
+	}
+	return t.estimateCost(n.Uint64(), to, opts.Value, opts.GasLimit, gp, data)
+}
+
+// prepare fills in gas limit and default options of the transaction and returns its gas prices
+func (t *MonitoredTransactor) prepare(to *common.Address, data []byte, opts *transactor.TransactOptions) ([]*big.Int, error) {
+	if opts.GasLimit == 0 && t.estimator != nil {
+		opts.GasLimit = t.estimator.EstimateGasLimit(t.client.From(), to, data, opts.Value)
+	}
+	err := transactor.MergeTransactionOptions(opts, &DefaultTransactionOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.GasPrice.Cmp(big.NewInt(0)) != 0 {
+		return []*big.Int{opts.GasPrice}, nil
+	}
+	return t.gasPriceClient.GasPrice(&opts.Priority)
+}
+
+// estimateCost returns estimated total cost of the transaction with the nonce. Transaction is
+// signed, but not sent, as L1 data fee on rollups depends on the signed transaction.
+func (t *MonitoredTransactor) estimateCost(nonce uint64, to *common.Address, value *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte) (*big.Int, error) {
+	tx, err := t.txFabric(nonce, to, value, gasLimit, gasPrices, data)
+	if err != nil {
+		return nil, err
+	}
+	rawTx, err := t.client.SignTransaction(context.TODO(), tx)
+	if err != nil {
+		return nil, err
+	}
+	cost, err := t.costEstimator.EstimateCost(gasLimit, gasPrices, rawTx)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug().Msgf("Estimated transaction cost %s", cost)
+	return cost, nil
+}
+
+// Pending returns nonces of sent transactions that are not yet mined
+func (t *MonitoredTransactor) Pending() []uint64 {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	nonces := make([]uint64, 0, len(t.pending))
+	for n := range t.pending {
+		nonces = append(nonces, n)
+	}
+	return nonces
+}
+
+// Cancel replaces pending transaction with the nonce with zero value transfer to sender itself.
+// The nonce stays tracked, and the cancellation is replaced with bumped fees, until any of the
+// transactions sent with the nonce is mined.
+func (t *MonitoredTransactor) Cancel(nonce uint64) (*common.Hash, error) {
+	t.lock.Lock()
+	tx, ok := t.pending[nonce]
+	var oldGasPrices []*big.Int
+	if ok {
+		oldGasPrices = tx.gasPrices
+	}
+	t.lock.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %d", ErrNotTracked, nonce)
+	}
+
+	gp, err := t.bumpedGasPrices(oldGasPrices, tx.priority)
+	if err != nil {
+		return nil, err
+	}
+	from := t.client.From()
+	h, err := t.resend(tx, &from, big.NewInt(0), cancelGasLimit, gp, nil)
+	if err != nil {
+		return nil, err
+	}
+	t.lock.Lock()
+	tx.cancels[h] = true
+	t.lock.Unlock()
+	log.Info().Uint64("nonce", nonce).Msgf("Cancelling transaction with %s", h)
+	return &h, nil
+}
+
+// FillNonceGap sends zero value transfer to sender itself with the nonce left unused by dropped
+// transaction and tracks it as any other sent transaction. Tracked transaction with the nonce is
+// replaced with bumped fees instead, as node no longer knows it.
+func (t *MonitoredTransactor) FillNonceGap(nonce uint64) (common.Hash, error) {
+	t.lock.Lock()
+	tx, ok := t.pending[nonce]
+	t.lock.Unlock()
+	if ok {
+		return t.replace(tx)
+	}
+
+	priority := DefaultTransactionOptions.Priority
+	gp, err := t.gasPriceClient.GasPrice(&priority)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	tx = &sentTx{
+		nonce:    nonce,
+		priority: priority,
+		cancels:  make(map[common.Hash]bool),
+	}
+	from := t.client.From()
+	h, err := t.resend(tx, &from, big.NewInt(0), cancelGasLimit, gp, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	t.lock.Lock()
+	t.pending[nonce] = tx
+	t.lock.Unlock()
+	go t.track(tx, transactor.NewTxHandle(h, nonce))
+	return h, nil
+}
+
+func (t *MonitoredTransactor) send(to *common.Address, value *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte, priority uint8) (*sentTx, error) {
+	t.client.LockNonce()
+	defer t.client.UnlockNonce()
+
+	n, err := t.client.UnsafeNonce()
+	if err != nil {
+		return nil, err
+	}
+	tx := &sentTx{
+		nonce:    n.Uint64(),
+		priority: priority,
+		cancels:  make(map[common.Hash]bool),
+	}
+	h, err := t.signAndSend(tx.nonce, to, value, gasLimit, gasPrices, data)
+	if err != nil {
+		return nil, err
+	}
+	err = t.client.UnsafeIncreaseNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	tx.to = to
+	tx.value = value
+	tx.gasLimit = gasLimit
+	tx.gasPrices = gasPrices
+	tx.data = data
+	tx.hashes = []common.Hash{h}
+	tx.sentAt = time.Now()
+
+	t.lock.Lock()
+	t.pending[tx.nonce] = tx
+	t.lock.Unlock()
+	return tx, nil
+}
+
+// resend sends replacement of the tracked transaction and records its hash
+func (t *MonitoredTransactor) resend(tx *sentTx, to *common.Address, value *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte) (common.Hash, error) {
+	h, err := t.signAndSend(tx.nonce, to, value, gasLimit, gasPrices, data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	tx.to = to
+	tx.value = value
+	tx.gasLimit = gasLimit
+	tx.gasPrices = gasPrices
+	tx.data = data
+	tx.hashes = append(tx.hashes, h)
+	tx.sentAt = time.Now()
+	return h, nil
+}
+
+func (t *MonitoredTransactor) signAndSend(nonce uint64, to *common.Address, value *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte) (common.Hash, error) {
+	tx, err := t.txFabric(nonce, to, value, gasLimit, gasPrices, data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	h, err := t.client.SignAndSendTransaction(context.TODO(), tx)
+	if err != nil {
+		log.Error().Err(err).Uint64("nonce", nonce).Msg("Failed sending transaction")
+		return common.Hash{}, err
+	}
+	return h, nil
+}
+
+// track polls receipts of all transactions sent with the nonce, replacing them with
+// bumped fees when timeout passes. Transaction is cancelled once replacements are exhausted or it is
+// no longer needed. Handle is resolved when any of the transactions is mined, when the nonce is used by
+// a transaction the transactor did not send or when TrackTimeout passes.
+func (t *MonitoredTransactor) track(tx *sentTx, handle *transactor.TxHandle) {
+	resubmits := 0
+	deadline := time.Now().Add(t.opts.TrackTimeout)
+	for {
+		receipt := t.receipt(tx)
+		if receipt != nil {
+			t.lock.Lock()
+			delete(t.pending, tx.nonce)
+			cancelled := tx.cancels[receipt.TxHash]
+			t.lock.Unlock()
+			handle.Resolve(receiptResult(receipt, handle.Hash(), cancelled))
+			return
+		}
+		if time.Now().After(deadline) {
+			t.drop(tx, handle, fmt.Errorf("transaction %s was not mined in %s", handle.Hash(), t.opts.TrackTimeout))
+			return
+		}
+
+		t.lock.Lock()
+		timedOut := time.Since(tx.sentAt) >= t.opts.ResubmitTimeout
+		cancelling := len(tx.cancels) > 0
+		t.lock.Unlock()
+		if timedOut {
+			if t.nonceUsed(tx.nonce) {
+				// any of the tracked transactions could have been mined after receipts were checked
+				if t.receipt(tx) != nil {
+					continue
+				}
+				t.drop(tx, handle, fmt.Errorf("%w %d", ErrNonceUsed, tx.nonce))
+				return
+			}
+
+			if cancelling || resubmits >= t.opts.MaxResubmits || (tx.obsolete != nil && tx.obsolete()) {
+				_, err := t.Cancel(tx.nonce)
+				if err != nil {
+					log.Error().Err(err).Uint64("nonce", tx.nonce).Msg("Failed cancelling transaction")
+				}
+			} else {
+				resubmits++
+				_, err := t.replace(tx)
+				if err != nil {
+					log.Warn().Err(err).Uint64("nonce", tx.nonce).Msg("Failed replacing transaction")
+				}
+			}
+		}
+
+		time.Sleep(t.opts.ReceiptPollInterval)
+	}
+}
+
+// drop stops tracking the transaction and resolves its handle as dropped
+func (t *MonitoredTransactor) drop(tx *sentTx, handle *transactor.TxHandle, err error) {
+	t.lock.Lock()
+	delete(t.pending, tx.nonce)
+	t.lock.Unlock()
+	log.Error().Err(err).Uint64("nonce", tx.nonce).Msg("Dropped transaction")
+	handle.Resolve(&transactor.TxResult{Status: transactor.TxDropped, Err: err})
+}
+
+// nonceUsed returns true if transaction with the nonce was mined
+func (t *MonitoredTransactor) nonceUsed(nonce uint64) bool {
+	n, err := t.client.NonceAt(context.TODO(), t.client.From(), nil)
+	if err != nil {
+		log.Warn().Err(err).Uint64("nonce", nonce).Msg("Failed fetching account nonce")
+		return false
+	}
+	return n > nonce
+}
+
+// receiptResult returns outcome of the transaction by the mined transaction. Mined cancellation
+// drops the transaction, while mined original or replacement transaction is its result.
+func receiptResult(receipt *types.Receipt, originalHash common.Hash, cancelled bool) *transactor.TxResult {
+	if cancelled {
+		return &transactor.TxResult{
+			Status:  transactor.TxDropped,
+			Receipt: receipt,
+			Err:     fmt.Errorf("transaction %s was cancelled", originalHash),
+		}
+	}
+
+	status := transactor.TxMined
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		status = transactor.TxReverted
+	}
+	return &transactor.TxResult{
+		Status:   status,
+		Receipt:  receipt,
+		Replaced: receipt.TxHash != originalHash,
+	}
+}
+
+func (t *MonitoredTransactor) replace(tx *sentTx) (common.Hash, error) {
+	t.lock.Lock()
+	to, value, gasLimit, oldGasPrices, data := tx.to, tx.value, tx.gasLimit, tx.gasPrices, tx.data
+	t.lock.Unlock()
+
+	gp, err := t.bumpedGasPrices(oldGasPrices, tx.priority)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	h, err := t.resend(tx, to, value, gasLimit, gp, data)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	log.Info().Uint64("nonce", tx.nonce).Msgf("Replaced transaction with %s, gas prices %v", h, gp)
+	return h, nil
+}
+
+// receipt returns receipt of any of the transactions sent with the nonce
+func (t *MonitoredTransactor) receipt(tx *sentTx) *types.Receipt {
+	t.lock.Lock()
+	hashes := make([]common.Hash, len(tx.hashes))
+	copy(hashes, tx.hashes)
+	t.lock.Unlock()
+
+	for _, h := range hashes {
+		receipt, err := t.client.TransactionReceipt(context.TODO(), h)
+		if err == nil && receipt != nil {
+			return receipt
+		}
+	}
+	return nil
+}
+
+// bumpedGasPrices returns gas prices that satisfy replacement rules. Each price is
+// increased by at least FeeBumpPercent, or set to the current gas pricer suggestion for the
+// transaction priority if it is higher.
+func (t *MonitoredTransactor) bumpedGasPrices(old []*big.Int, priority uint8) ([]*big.Int, error) {
+	suggested, err := t.gasPriceClient.GasPrice(&priority)
+	if err != nil {
+		return nil, err
+	}
+
+	bumped := make([]*big.Int, len(old))
+	for i, p := range old {
+		bumped[i] = bumpFee(p, t.opts.FeeBumpPercent)
+		if len(suggested) == len(old) && suggested[i].Cmp(bumped[i]) > 0 {
+			bumped[i] = new(big.Int).Set(suggested[i])
+		}
+	}
+
+	// gas price of legacy or fee cap of dynamic fee transaction
+	feeCap := bumped[len(bumped)-1]
+	limit := t.opts.UpperLimitFeePerGas
+	if limit != nil && feeCap.Cmp(limit) > 0 {
+		if bumpFee(old[len(old)-1], MinFeeBumpPercent).Cmp(limit) > 0 {
+			return nil, fmt.Errorf("replacement fee exceeds upper limit %s", limit)
+		}
+		feeCap.Set(limit)
+	}
+	// tip cap can not be higher than fee cap
+	if len(bumped) > 1 && bumped[0].Cmp(feeCap) > 0 {
+		bumped[0].Set(feeCap)
+	}
+	return bumped, nil
+}
+
+func bumpFee(fee *big.Int, percent int64) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+percent))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Div(bumped, big.NewInt(100))
+}
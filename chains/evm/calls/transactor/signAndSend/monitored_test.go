@@ -0,0 +1,477 @@
+package signAndSend_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	mock_calls "github.com/ChainSafe/chainbridge-core/chains/evm/calls/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
+	mock_signAndSend "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend/mock"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type fabricCall struct {
+	nonce     uint64
+	to        *common.Address
+	amount    *big.Int
+	gasLimit  uint64
+	gasPrices []*big.Int
+	data      []byte
+}
+
+type MonitoredTransactorTestSuite struct {
+	suite.Suite
+	mockClient    *mock_signAndSend.MockMonitoredClient
+	mockGasPricer *mock_calls.MockGasPricer
+	fabricCalls   []fabricCall
+	opts          signAndSend.MonitorOpts
+	from          common.Address
+	to            common.Address
+}
+
+func TestRunMonitoredTransactorTestSuite(t *testing.T) {
+	suite.Run(t, new(MonitoredTransactorTestSuite))
+}
+
+func (s *MonitoredTransactorTestSuite) SetupSuite()    {}
+func (s *MonitoredTransactorTestSuite) TearDownSuite() {}
+func (s *MonitoredTransactorTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_signAndSend.NewMockMonitoredClient(gomockController)
+	s.mockGasPricer = mock_calls.NewMockGasPricer(gomockController)
+	s.fabricCalls = []fabricCall{}
+	s.opts = signAndSend.MonitorOpts{
+		ResubmitTimeout:     time.Hour,
+		MaxResubmits:        1,
+		ReceiptPollInterval: time.Millisecond,
+	}
+	s.from = common.HexToAddress("0xf")
+	s.to = common.HexToAddress("0x1")
+}
+func (s *MonitoredTransactorTestSuite) TearDownTest() {}
+
+func (s *MonitoredTransactorTestSuite) expectNonce() {
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(7), nil)
+	s.mockClient.EXPECT().UnsafeIncreaseNonce().Return(nil)
+	s.mockClient.EXPECT().UnlockNonce()
+}
+
+// expectNonceNotUsed expects account nonce checks of timed out transactions that report the nonce is not yet used
+func (s *MonitoredTransactorTestSuite) expectNonceNotUsed() {
+	s.mockClient.EXPECT().From().Return(s.from).AnyTimes()
+	s.mockClient.EXPECT().NonceAt(gomock.Any(), s.from, nil).Return(uint64(7), nil).AnyTimes()
+}
+
+func (s *MonitoredTransactorTestSuite) transactor() *signAndSend.MonitoredTransactor {
+	fabric := func(nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrices []*big.Int, data []byte) (evmclient.CommonTransaction, error) {
+		s.fabricCalls = append(s.fabricCalls, fabricCall{nonce, to, amount, gasLimit, gasPrices, data})
+		return evmtransaction.NewTransaction(nonce, to, amount, gasLimit, gasPrices, data)
+	}
+	return signAndSend.NewMonitoredTransactor(fabric, s.mockGasPricer, s.mockClient, s.opts)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_MinedWithoutReplacement() {
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusSuccessful}, nil)
+
+	t := s.transactor()
+	h, err := t.Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(common.Hash{1}, *h)
+	s.Empty(t.Pending())
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_FailedReceipt() {
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusFailed}, nil)
+
+	_, err := s.transactor().Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	var txErr *evmclient.TransactionFailedError
+	s.True(errors.As(err, &txErr))
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_ReplacedWithBumpedFees() {
+	s.opts.ResubmitTimeout = 0
+	fast := uint8(3)
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(&fast).Return([]*big.Int{big.NewInt(10), big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound)
+	// suggested tip is higher than bumped tip, suggested fee cap is lower than bumped one
+	s.mockGasPricer.EXPECT().GasPrice(&fast).Return([]*big.Int{big.NewInt(20), big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{2}).Return(&types.Receipt{TxHash: common.Hash{2}, Status: types.ReceiptStatusSuccessful}, nil)
+	s.expectNonceNotUsed()
+
+	h, err := s.transactor().Transact(&s.to, []byte{1}, transactor.TransactOptions{Priority: fast})
+
+	s.Nil(err)
+	s.Equal(common.Hash{2}, *h)
+	s.Len(s.fabricCalls, 2)
+	s.Equal(uint64(7), s.fabricCalls[1].nonce)
+	s.Equal([]*big.Int{big.NewInt(20), big.NewInt(110)}, s.fabricCalls[1].gasPrices)
+	s.Equal([]byte{1}, s.fabricCalls[1].data)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_ReplacementCappedByUpperLimit() {
+	s.opts.ResubmitTimeout = 0
+	s.opts.FeeBumpPercent = 50
+	s.opts.UpperLimitFeePerGas = big.NewInt(120)
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), gomock.Any()).Return(&types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusSuccessful}, nil)
+	s.expectNonceNotUsed()
+
+	h, err := s.transactor().Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(common.Hash{1}, *h)
+	s.Equal([]*big.Int{big.NewInt(120)}, s.fabricCalls[1].gasPrices)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_CancelledAfterMaxResubmits() {
+	s.opts.ResubmitTimeout = 0
+	s.opts.MaxResubmits = 0
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound).Times(2)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().From().Return(s.from)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{2}).Return(&types.Receipt{TxHash: common.Hash{2}, Status: types.ReceiptStatusSuccessful}, nil)
+	s.expectNonceNotUsed()
+
+	t := s.transactor()
+	_, err := t.Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+	s.Empty(t.Pending())
+	cancel := s.fabricCalls[1]
+	s.Equal(s.from, *cancel.to)
+	s.Equal(big.NewInt(0), cancel.amount)
+	s.Equal(uint64(21000), cancel.gasLimit)
+	s.Nil(cancel.data)
+	s.Equal([]*big.Int{big.NewInt(110)}, cancel.gasPrices)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_ReplacementAboveUpperLimitNotSent() {
+	s.opts.ResubmitTimeout = 0
+	s.opts.MaxResubmits = 0
+	s.opts.UpperLimitFeePerGas = big.NewInt(100)
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusSuccessful}, nil)
+	s.expectNonceNotUsed()
+
+	t := s.transactor()
+	h, err := t.Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(common.Hash{1}, *h)
+	s.Len(s.fabricCalls, 1)
+	s.Empty(t.Pending())
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_ObsoleteTransactionCancelledInsteadOfReplaced() {
+	s.opts.ResubmitTimeout = 0
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound).Times(2)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().From().Return(s.from)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{2}).Return(&types.Receipt{TxHash: common.Hash{2}, Status: types.ReceiptStatusSuccessful}, nil)
+	s.expectNonceNotUsed()
+
+	t := s.transactor()
+	handle, err := t.TransactAsync(&s.to, []byte{1}, transactor.TransactOptions{Obsolete: func() bool { return true }})
+	s.Nil(err)
+
+	result := handle.Wait()
+	s.Equal(transactor.TxDropped, result.Status)
+	s.Equal(s.from, *s.fabricCalls[1].to)
+	s.Empty(t.Pending())
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_CancellationReplacedWithBumpedFees() {
+	s.opts.ResubmitTimeout = 0
+	s.opts.MaxResubmits = 0
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound).Times(3)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil).Times(2)
+	s.mockClient.EXPECT().From().Return(s.from).Times(2)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{2}).Return(nil, ethereum.NotFound).Times(2)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{3}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{3}).Return(&types.Receipt{TxHash: common.Hash{3}, Status: types.ReceiptStatusSuccessful}, nil)
+	s.expectNonceNotUsed()
+
+	_, err := s.transactor().Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+	s.Len(s.fabricCalls, 3)
+	s.Equal([]*big.Int{big.NewInt(110)}, s.fabricCalls[1].gasPrices)
+	s.Equal([]*big.Int{big.NewInt(121)}, s.fabricCalls[2].gasPrices)
+}
+
+func (s *MonitoredTransactorTestSuite) TestCancel_NotTracked() {
+	_, err := signAndSend.NewMonitoredTransactor(evmtransaction.NewTransaction, s.mockGasPricer, s.mockClient, s.opts).Cancel(3)
+
+	s.True(errors.Is(err, signAndSend.ErrNotTracked))
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransactAsync_ResolvedWhenReplacementMined() {
+	s.opts.ResubmitTimeout = 0
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{2}).Return(&types.Receipt{TxHash: common.Hash{2}, Status: types.ReceiptStatusSuccessful}, nil)
+	s.expectNonceNotUsed()
+
+	handle, err := s.transactor().TransactAsync(&s.to, []byte{1}, transactor.TransactOptions{})
+	s.Nil(err)
+	s.Equal(common.Hash{1}, handle.Hash())
+
+	result := handle.Wait()
+	s.Equal(transactor.TxMined, result.Status)
+	s.True(result.Replaced)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransactAsync_CancelledTransactionDropped() {
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound).AnyTimes()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().From().Return(s.from)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{2}).Return(&types.Receipt{TxHash: common.Hash{2}, Status: types.ReceiptStatusSuccessful}, nil).AnyTimes()
+
+	t := s.transactor()
+	handle, err := t.TransactAsync(&s.to, []byte{1}, transactor.TransactOptions{})
+	s.Nil(err)
+	_, err = t.Cancel(handle.Nonce())
+	s.Nil(err)
+
+	result := handle.Wait()
+	s.Equal(transactor.TxDropped, result.Status)
+	s.NotNil(result.Err)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransactAsync_OriginalMinedAfterCancel() {
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().From().Return(s.from)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{2}, nil)
+	cancelled := make(chan struct{})
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).DoAndReturn(func(_ interface{}, _ common.Hash) (*types.Receipt, error) {
+		<-cancelled
+		return &types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusSuccessful}, nil
+	})
+
+	t := s.transactor()
+	handle, err := t.TransactAsync(&s.to, []byte{1}, transactor.TransactOptions{})
+	s.Nil(err)
+	_, err = t.Cancel(handle.Nonce())
+	s.Nil(err)
+	close(cancelled)
+
+	result := handle.Wait()
+	s.Equal(transactor.TxMined, result.Status)
+	s.False(result.Replaced)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_GasLimitEstimated() {
+	estimator := mock_signAndSend.NewMockGasLimitEstimator(gomock.NewController(s.T()))
+	estimator.EXPECT().EstimateGasLimit(s.from, &s.to, []byte{1}, nil).Return(uint64(60000))
+	s.mockClient.EXPECT().From().Return(s.from)
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusSuccessful}, nil)
+
+	t := s.transactor()
+	t.SetGasLimitEstimator(estimator)
+	_, err := t.Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(uint64(60000), s.fabricCalls[0].gasLimit)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_ProvidedGasLimitNotEstimated() {
+	estimator := mock_signAndSend.NewMockGasLimitEstimator(gomock.NewController(s.T()))
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusSuccessful}, nil)
+
+	t := s.transactor()
+	t.SetGasLimitEstimator(estimator)
+	_, err := t.Transact(&s.to, []byte{1}, transactor.TransactOptions{GasLimit: 100000})
+
+	s.Nil(err)
+	s.Equal(uint64(100000), s.fabricCalls[0].gasLimit)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_CostTracked() {
+	gomockController := gomock.NewController(s.T())
+	costEstimator := mock_signAndSend.NewMockCostEstimator(gomockController)
+	costTracker := mock_signAndSend.NewMockCostTracker(gomockController)
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().SignTransaction(gomock.Any(), gomock.Any()).Return([]byte{2, 3}, nil)
+	costEstimator.EXPECT().EstimateCost(uint64(2000000), []*big.Int{big.NewInt(100)}, []byte{2, 3}).Return(big.NewInt(300000000), nil)
+	costTracker.EXPECT().TrackTransactionCost(uint8(2), big.NewInt(300000000))
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusSuccessful}, nil)
+
+	t := s.transactor()
+	t.SetCostTracking(2, costEstimator, costTracker)
+	_, err := t.Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.Nil(err)
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransact_CostEstimationFailureNotFatal() {
+	gomockController := gomock.NewController(s.T())
+	costEstimator := mock_signAndSend.NewMockCostEstimator(gomockController)
+	costTracker := mock_signAndSend.NewMockCostTracker(gomockController)
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().SignTransaction(gomock.Any(), gomock.Any()).Return([]byte{2, 3}, nil)
+	costEstimator.EXPECT().EstimateCost(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusSuccessful}, nil)
+
+	t := s.transactor()
+	t.SetCostTracking(2, costEstimator, costTracker)
+	_, err := t.Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.Nil(err)
+}
+
+func (s *MonitoredTransactorTestSuite) TestEstimateTransactionCost_SignedTransactionNotSent() {
+	gomockController := gomock.NewController(s.T())
+	costEstimator := mock_signAndSend.NewMockCostEstimator(gomockController)
+	costTracker := mock_signAndSend.NewMockCostTracker(gomockController)
+	s.mockClient.EXPECT().LockNonce()
+	s.mockClient.EXPECT().UnsafeNonce().Return(big.NewInt(7), nil)
+	s.mockClient.EXPECT().UnlockNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignTransaction(gomock.Any(), gomock.Any()).Return([]byte{2, 3}, nil)
+	costEstimator.EXPECT().EstimateCost(uint64(2000000), []*big.Int{big.NewInt(100)}, []byte{2, 3}).Return(big.NewInt(300000000), nil)
+
+	t := s.transactor()
+	t.SetCostTracking(2, costEstimator, costTracker)
+	cost, err := t.EstimateTransactionCost(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.Nil(err)
+	s.Equal(big.NewInt(300000000), cost)
+	s.Equal(uint64(7), s.fabricCalls[0].nonce)
+	s.Empty(t.Pending())
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransactAsync_NonceUsedByOtherTransactionDropped() {
+	s.opts.ResubmitTimeout = 0
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound).Times(2)
+	s.mockClient.EXPECT().From().Return(s.from)
+	s.mockClient.EXPECT().NonceAt(gomock.Any(), s.from, nil).Return(uint64(8), nil)
+
+	t := s.transactor()
+	handle, err := t.TransactAsync(&s.to, []byte{1}, transactor.TransactOptions{})
+	s.Nil(err)
+
+	result := handle.Wait()
+	s.Equal(transactor.TxDropped, result.Status)
+	s.True(errors.Is(result.Err, signAndSend.ErrNonceUsed))
+	s.Len(s.fabricCalls, 1)
+	s.Empty(t.Pending())
+}
+
+func (s *MonitoredTransactorTestSuite) TestTransactAsync_DroppedAfterTrackTimeout() {
+	s.opts.TrackTimeout = time.Nanosecond
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(nil, ethereum.NotFound).AnyTimes()
+
+	t := s.transactor()
+	_, err := t.Transact(&s.to, []byte{1}, transactor.TransactOptions{})
+
+	s.NotNil(err)
+	s.Empty(t.Pending())
+}
+
+func (s *MonitoredTransactorTestSuite) TestFillNonceGap_UntrackedNonceSentAndTracked() {
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil)
+	s.mockClient.EXPECT().From().Return(s.from)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), common.Hash{1}).Return(&types.Receipt{TxHash: common.Hash{1}, Status: types.ReceiptStatusSuccessful}, nil).AnyTimes()
+
+	h, err := s.transactor().FillNonceGap(3)
+
+	s.Nil(err)
+	s.Equal(common.Hash{1}, h)
+	s.Len(s.fabricCalls, 1)
+	s.Equal(uint64(3), s.fabricCalls[0].nonce)
+	s.Equal(s.from, *s.fabricCalls[0].to)
+	s.Equal(big.NewInt(0), s.fabricCalls[0].amount)
+	s.Equal(uint64(21000), s.fabricCalls[0].gasLimit)
+}
+
+func (s *MonitoredTransactorTestSuite) TestFillNonceGap_TrackedNonceReplaced() {
+	s.expectNonce()
+	s.mockGasPricer.EXPECT().GasPrice(gomock.Any()).Return([]*big.Int{big.NewInt(100)}, nil).Times(2)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{1}, nil)
+	s.mockClient.EXPECT().SignAndSendTransaction(gomock.Any(), gomock.Any()).Return(common.Hash{2}, nil)
+	s.mockClient.EXPECT().TransactionReceipt(gomock.Any(), gomock.Any()).Return(nil, ethereum.NotFound).AnyTimes()
+
+	t := s.transactor()
+	handle, err := t.TransactAsync(&s.to, []byte{1}, transactor.TransactOptions{})
+	s.Nil(err)
+	h, err := t.FillNonceGap(handle.Nonce())
+
+	s.Nil(err)
+	s.Equal(common.Hash{2}, h)
+	s.Len(s.fabricCalls, 2)
+	s.Equal(s.to, *s.fabricCalls[1].to)
+	s.Equal([]byte{1}, s.fabricCalls[1].data)
+	s.Equal([]*big.Int{big.NewInt(110)}, s.fabricCalls[1].gasPrices)
+}
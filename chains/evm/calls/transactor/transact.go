@@ -15,6 +15,9 @@ type TransactOptions struct {
 	Nonce    *big.Int
 	ChainID  *big.Int
 	Priority uint8
+	// Obsolete reports if the transaction is no longer needed. Transactors that replace transactions
+	// not mined in time cancel obsolete transactions instead. If nil - transaction is always needed
+	Obsolete func() bool
 }
 
 // to save on data, we encode uin8 for transaction priority
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
 	"github.com/ChainSafe/chainbridge-core/store"
 	"github.com/rs/zerolog/log"
@@ -68,6 +69,10 @@ func (c *EVMChain) Write(msg []*message.Message) {
 	for _, msg := range msg {
 		go func(msg *message.Message) {
 			err := c.writer.Execute(msg)
+			if executor.IsDropped(err) {
+				log.Warn().Err(err).Msgf("Dropped message %v", msg)
+				return
+			}
 			if err != nil {
 				log.Err(err).Msgf("Failed writing message %v", msg)
 			}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/executor/shadow.go
+
+// Package mock_executor is a generated GoMock package.
+package mock_executor
+
+import (
+	reflect "reflect"
+
+	executor "github.com/ChainSafe/chainbridge-core/chains/evm/executor"
+	proposal "github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
+	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	common "github.com/ethereum/go-ethereum/common"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockShadowBridgeContract is a mock of ShadowBridgeContract interface.
+type MockShadowBridgeContract struct {
+	ctrl     *gomock.Controller
+	recorder *MockShadowBridgeContractMockRecorder
+}
+
+// MockShadowBridgeContractMockRecorder is the mock recorder for MockShadowBridgeContract.
+type MockShadowBridgeContractMockRecorder struct {
+	mock *MockShadowBridgeContract
+}
+
+// NewMockShadowBridgeContract creates a new mock instance.
+func NewMockShadowBridgeContract(ctrl *gomock.Controller) *MockShadowBridgeContract {
+	mock := &MockShadowBridgeContract{ctrl: ctrl}
+	mock.recorder = &MockShadowBridgeContractMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShadowBridgeContract) EXPECT() *MockShadowBridgeContractMockRecorder {
+	return m.recorder
+}
+
+// IsProposalVotedBy mocks base method.
+func (m *MockShadowBridgeContract) IsProposalVotedBy(by common.Address, p *proposal.Proposal) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsProposalVotedBy", by, p)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsProposalVotedBy indicates an expected call of IsProposalVotedBy.
+func (mr *MockShadowBridgeContractMockRecorder) IsProposalVotedBy(by, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsProposalVotedBy", reflect.TypeOf((*MockShadowBridgeContract)(nil).IsProposalVotedBy), by, p)
+}
+
+// ProposalStatus mocks base method.
+func (m *MockShadowBridgeContract) ProposalStatus(p *proposal.Proposal) (message.ProposalStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposalStatus", p)
+	ret0, _ := ret[0].(message.ProposalStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposalStatus indicates an expected call of ProposalStatus.
+func (mr *MockShadowBridgeContractMockRecorder) ProposalStatus(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposalStatus", reflect.TypeOf((*MockShadowBridgeContract)(nil).ProposalStatus), p)
+}
+
+// MockShadowReporter is a mock of ShadowReporter interface.
+type MockShadowReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockShadowReporterMockRecorder
+}
+
+// MockShadowReporterMockRecorder is the mock recorder for MockShadowReporter.
+type MockShadowReporterMockRecorder struct {
+	mock *MockShadowReporter
+}
+
+// NewMockShadowReporter creates a new mock instance.
+func NewMockShadowReporter(ctrl *gomock.Controller) *MockShadowReporter {
+	mock := &MockShadowReporter{ctrl: ctrl}
+	mock.recorder = &MockShadowReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShadowReporter) EXPECT() *MockShadowReporterMockRecorder {
+	return m.recorder
+}
+
+// Report mocks base method.
+func (m *MockShadowReporter) Report(report *executor.ShadowReport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Report", report)
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockShadowReporterMockRecorder) Report(report interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockShadowReporter)(nil).Report), report)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ChainSafe/chainbridge-core/chains/evm/executor (interfaces: ChainClient,MessageHandler,BridgeContract)
+// Source: github.com/ChainSafe/chainbridge-core/chains/evm/executor (interfaces: ChainClient,MessageHandler,BridgeContract,MessageVerifier)
 
 // Package mock_executor is a generated GoMock package.
 package mock_executor
@@ -364,3 +364,55 @@ func (mr *MockBridgeContractMockRecorder) VoteProposal(arg0, arg1 interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteProposal", reflect.TypeOf((*MockBridgeContract)(nil).VoteProposal), arg0, arg1)
 }
+
+// VoteProposalAsync mocks base method.
+func (m *MockBridgeContract) VoteProposalAsync(arg0 *proposal.Proposal, arg1 transactor.TransactOptions) (*transactor.TxHandle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteProposalAsync", arg0, arg1)
+	ret0, _ := ret[0].(*transactor.TxHandle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoteProposalAsync indicates an expected call of VoteProposalAsync.
+func (mr *MockBridgeContractMockRecorder) VoteProposalAsync(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteProposalAsync", reflect.TypeOf((*MockBridgeContract)(nil).VoteProposalAsync), arg0, arg1)
+}
+
+// MockMessageVerifier is a mock of MessageVerifier interface.
+type MockMessageVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageVerifierMockRecorder
+}
+
+// MockMessageVerifierMockRecorder is the mock recorder for MockMessageVerifier.
+type MockMessageVerifierMockRecorder struct {
+	mock *MockMessageVerifier
+}
+
+// NewMockMessageVerifier creates a new mock instance.
+func NewMockMessageVerifier(ctrl *gomock.Controller) *MockMessageVerifier {
+	mock := &MockMessageVerifier{ctrl: ctrl}
+	mock.recorder = &MockMessageVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageVerifier) EXPECT() *MockMessageVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockMessageVerifier) Verify(arg0 *message.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockMessageVerifierMockRecorder) Verify(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockMessageVerifier)(nil).Verify), arg0)
+}
@@ -0,0 +1,150 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package executor
+
+import (
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	maxShadowStatusChecks   = 40
+	shadowStatusCheckPeriod = 15
+)
+
+type ShadowBridgeContract interface {
+	IsProposalVotedBy(by common.Address, p *proposal.Proposal) (bool, error)
+	ProposalStatus(p *proposal.Proposal) (message.ProposalStatus, error)
+}
+
+// ShadowReport describes how the proposal the shadow voter would have voted for
+// compares to votes that were actually cast on chain
+type ShadowReport struct {
+	Proposal *proposal.Proposal
+	Status   message.ProposalStatus
+	// MissingVotes are watched relayers that did not vote for the proposal.
+	// Relayers skip voting on proposals that already satisfy threshold so missing
+	// votes on a passed proposal are not a mismatch by themselves.
+	MissingVotes []common.Address
+	Err          error
+}
+
+// Mismatch returns true if the proposal the shadow voter would have voted for
+// was not passed on chain, meaning other relayers voted for different proposal data
+// or did not vote at all
+func (r *ShadowReport) Mismatch() bool {
+	if r.Err != nil {
+		return false
+	}
+	return r.Status.Status != message.ProposalStatusPassed && r.Status.Status != message.ProposalStatusExecuted
+}
+
+type ShadowReporter interface {
+	Report(report *ShadowReport)
+}
+
+// LogShadowReporter is ShadowReporter that logs shadow reports
+type LogShadowReporter struct{}
+
+func (r *LogShadowReporter) Report(report *ShadowReport) {
+	prop := report.Proposal
+	switch {
+	case report.Err != nil:
+		log.Error().Err(report.Err).Uint8("src", prop.Source).Uint64("nonce", prop.DepositNonce).Msg("Shadow comparison failed")
+	case report.Mismatch():
+		log.Warn().
+			Uint8("src", prop.Source).
+			Uint64("nonce", prop.DepositNonce).
+			Str("dataHash", prop.GetDataHash().Hex()).
+			Str("status", message.StatusMap[report.Status.Status]).
+			Uint8("yesVotes", report.Status.YesVotesTotal).
+			Msgf("Shadow proposal mismatch, missing votes from %v", report.MissingVotes)
+	default:
+		log.Info().
+			Uint8("src", prop.Source).
+			Uint64("nonce", prop.DepositNonce).
+			Msgf("Shadow proposal matches on chain votes, missing votes from %v", report.MissingVotes)
+	}
+}
+
+// ShadowVoter is a ProposalExecutor that builds proposals the same way
+// EVMVoter does but never sends transactions. Instead it waits for other relayers to vote
+// and reports whether on chain votes match the proposal it would have voted for.
+type ShadowVoter struct {
+	mh             MessageHandler
+	bridgeContract ShadowBridgeContract
+	relayers       []common.Address
+	reporter       ShadowReporter
+}
+
+// NewShadowVoter creates an instance of ShadowVoter that compares proposals
+// with votes of provided production relayers
+func NewShadowVoter(mh MessageHandler, bridgeContract ShadowBridgeContract, relayers []common.Address, reporter ShadowReporter) *ShadowVoter {
+	return &ShadowVoter{
+		mh:             mh,
+		bridgeContract: bridgeContract,
+		relayers:       relayers,
+		reporter:       reporter,
+	}
+}
+
+// Execute builds proposal from the message and, without blocking, reports how it
+// compares to on chain votes once the proposal is finalized or checks run out.
+func (v *ShadowVoter) Execute(m *message.Message) error {
+	prop, err := v.mh.HandleMessage(m)
+	if err != nil {
+		return err
+	}
+
+	go v.compare(prop)
+	return nil
+}
+
+// compare waits for the proposal to be finalized and reports relayers that did not vote for it
+func (v *ShadowVoter) compare(prop *proposal.Proposal) {
+	report := &ShadowReport{Proposal: prop}
+	defer v.reporter.Report(report)
+
+	report.Status, report.Err = v.waitForFinalStatus(prop)
+	if report.Err != nil {
+		return
+	}
+
+	for _, relayer := range v.relayers {
+		voted, err := v.bridgeContract.IsProposalVotedBy(relayer, prop)
+		if err != nil {
+			report.Err = err
+			return
+		}
+		if !voted {
+			report.MissingVotes = append(report.MissingVotes, relayer)
+		}
+	}
+}
+
+// waitForFinalStatus periodically checks proposal status until
+// it is passed, executed or canceled and returns the last known status.
+func (v *ShadowVoter) waitForFinalStatus(prop *proposal.Proposal) (message.ProposalStatus, error) {
+	var ps message.ProposalStatus
+	var err error
+	for i := 0; i < maxShadowStatusChecks; i++ {
+		ps, err = v.bridgeContract.ProposalStatus(prop)
+		if err != nil {
+			return ps, err
+		}
+
+		if ps.Status == message.ProposalStatusPassed ||
+			ps.Status == message.ProposalStatusExecuted ||
+			ps.Status == message.ProposalStatusCanceled {
+			return ps, nil
+		}
+
+		Sleep(shadowStatusCheckPeriod * time.Second)
+	}
+	return ps, nil
+}
@@ -0,0 +1,104 @@
+package executor_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
+	mock_executor "github.com/ChainSafe/chainbridge-core/chains/evm/executor/mock"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ShadowVoterTestSuite struct {
+	suite.Suite
+	shadowVoter        *executor.ShadowVoter
+	mockMessageHandler *mock_executor.MockMessageHandler
+	mockBridgeContract *mock_executor.MockShadowBridgeContract
+	mockReporter       *mock_executor.MockShadowReporter
+	relayers           []common.Address
+	reported           chan *executor.ShadowReport
+}
+
+func TestRunShadowVoterTestSuite(t *testing.T) {
+	suite.Run(t, new(ShadowVoterTestSuite))
+}
+
+func (s *ShadowVoterTestSuite) SetupSuite()    {}
+func (s *ShadowVoterTestSuite) TearDownSuite() {}
+func (s *ShadowVoterTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockMessageHandler = mock_executor.NewMockMessageHandler(gomockController)
+	s.mockBridgeContract = mock_executor.NewMockShadowBridgeContract(gomockController)
+	s.mockReporter = mock_executor.NewMockShadowReporter(gomockController)
+	s.relayers = []common.Address{common.HexToAddress("0x1"), common.HexToAddress("0x2")}
+	s.reported = make(chan *executor.ShadowReport, 1)
+	s.shadowVoter = executor.NewShadowVoter(
+		s.mockMessageHandler,
+		s.mockBridgeContract,
+		s.relayers,
+		s.mockReporter,
+	)
+	executor.Sleep = func(d time.Duration) {}
+}
+func (s *ShadowVoterTestSuite) TearDownTest() {}
+
+func (s *ShadowVoterTestSuite) report(r *executor.ShadowReport) {
+	s.reported <- r
+}
+
+func (s *ShadowVoterTestSuite) TestExecute_HandleMessageError() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(nil, errors.New("error"))
+
+	err := s.shadowVoter.Execute(&message.Message{})
+
+	s.NotNil(err)
+}
+
+func (s *ShadowVoterTestSuite) TestExecute_ProposalStatusError() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{}, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{}, errors.New("error"))
+	s.mockReporter.EXPECT().Report(gomock.Any()).Do(s.report)
+
+	err := s.shadowVoter.Execute(&message.Message{})
+
+	s.Nil(err)
+	r := <-s.reported
+	s.NotNil(r.Err)
+	s.False(r.Mismatch())
+}
+
+func (s *ShadowVoterTestSuite) TestExecute_ProposalPassed() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{}, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusPassed}, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(s.relayers[0], gomock.Any()).Return(true, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(s.relayers[1], gomock.Any()).Return(false, nil)
+	s.mockReporter.EXPECT().Report(gomock.Any()).Do(s.report)
+
+	err := s.shadowVoter.Execute(&message.Message{})
+
+	s.Nil(err)
+	r := <-s.reported
+	s.Nil(r.Err)
+	s.False(r.Mismatch())
+	s.Equal([]common.Address{s.relayers[1]}, r.MissingVotes)
+}
+
+func (s *ShadowVoterTestSuite) TestExecute_ProposalNeverPassed() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{}, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Times(40).Return(message.ProposalStatus{Status: message.ProposalStatusInactive}, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Times(2).Return(false, nil)
+	s.mockReporter.EXPECT().Report(gomock.Any()).Do(s.report)
+
+	err := s.shadowVoter.Execute(&message.Message{})
+
+	s.Nil(err)
+	r := <-s.reported
+	s.True(r.Mismatch())
+	s.Equal(s.relayers, r.MissingVotes)
+}
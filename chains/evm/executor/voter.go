@@ -5,6 +5,7 @@ package executor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -49,15 +50,43 @@ type MessageHandler interface {
 type BridgeContract interface {
 	IsProposalVotedBy(by common.Address, p *proposal.Proposal) (bool, error)
 	VoteProposal(proposal *proposal.Proposal, opts transactor.TransactOptions) (*common.Hash, error)
+	VoteProposalAsync(proposal *proposal.Proposal, opts transactor.TransactOptions) (*transactor.TxHandle, error)
 	SimulateVoteProposal(proposal *proposal.Proposal) error
 	ProposalStatus(p *proposal.Proposal) (message.ProposalStatus, error)
 	GetThreshold() (uint8, error)
 }
 
+type MessageVerifier interface {
+	Verify(m *message.Message) error
+}
+
+// DroppedMessageError is returned when vote for the message reverted. Reverts are deterministic,
+// so the message is dropped instead of being retried.
+type DroppedMessageError struct {
+	Err error
+}
+
+func (e *DroppedMessageError) Error() string {
+	return fmt.Sprintf("message dropped: %s", e.Err)
+}
+
+func (e *DroppedMessageError) Unwrap() error {
+	return e.Err
+}
+
+// IsDropped returns true if the message failed with an error it should not be retried for
+func IsDropped(err error) bool {
+	var droppedErr *DroppedMessageError
+	return errors.As(err, &droppedErr)
+}
+
 type EVMVoter struct {
 	mh                   MessageHandler
 	client               ChainClient
 	bridgeContract       BridgeContract
+	verifier             MessageVerifier
+	async                bool
+	relayerAddresses     []common.Address
 	pendingProposalVotes map[common.Hash]uint8
 }
 
@@ -100,15 +129,42 @@ func NewVoter(mh MessageHandler, client ChainClient, bridgeContract BridgeContra
 	}
 }
 
+// SetVerifier sets verifier that has to accept every message before
+// the voter starts handling it
+func (v *EVMVoter) SetVerifier(verifier MessageVerifier) {
+	v.verifier = verifier
+}
+
+// EnableAsyncVoting makes voter send votes without waiting for them to be mined.
+// Bridge contract transactor has to support asynchronous transactions.
+func (v *EVMVoter) EnableAsyncVoting() {
+	v.async = true
+}
+
+// SetRelayerAddresses sets addresses the relayer votes with when sending votes with a pool
+// of keys. Proposal voted by any of them is considered voted by the relayer so that the
+// relayer never votes for the same proposal twice.
+func (v *EVMVoter) SetRelayerAddresses(addresses []common.Address) {
+	v.relayerAddresses = addresses
+}
+
 // Execute checks if relayer already voted and is threshold
 // satisfied and casts a vote if it isn't.
 func (v *EVMVoter) Execute(m *message.Message) error {
+	if v.verifier != nil {
+		err := v.verifier.Verify(m)
+		if err != nil {
+			log.Error().Err(err).Msgf("Verification of message %+v failed, refusing to vote", m)
+			return fmt.Errorf("message verification failed. Err: %w", err)
+		}
+	}
+
 	prop, err := v.mh.HandleMessage(m)
 	if err != nil {
 		return err
 	}
 
-	votedByTheRelayer, err := v.bridgeContract.IsProposalVotedBy(v.client.RelayerAddress(), prop)
+	votedByTheRelayer, err := v.isProposalVotedByRelayer(prop)
 	if err != nil {
 		log.Error().Err(err).Msgf("Fetching is proposal %v voted by relayer failed", prop)
 		return err
@@ -129,12 +185,24 @@ func (v *EVMVoter) Execute(m *message.Message) error {
 	}
 	err = v.repetitiveSimulateVote(prop, 0)
 	if err != nil {
+		if calls.IsRevert(err) {
+			log.Error().Err(err).Msgf("Simulated proposal %+v vote reverted, dropping message", prop)
+			return &DroppedMessageError{Err: err}
+		}
 		log.Error().Err(err).Msgf("Simulating proposal %+v vote failed", prop)
 		return err
 	}
 
-	hash, err := v.bridgeContract.VoteProposal(prop, transactor.TransactOptions{Priority: prop.Metadata.Priority})
+	if v.async {
+		return v.voteAsync(prop)
+	}
+
+	hash, err := v.bridgeContract.VoteProposal(prop, v.voteOptions(prop))
 	if err != nil {
+		if calls.IsRevert(err) {
+			log.Error().Err(err).Msgf("Vote for proposal %+v reverted, dropping message", prop)
+			return &DroppedMessageError{Err: err}
+		}
 		log.Error().Err(err).Msgf("voting for proposal %+v failed", prop)
 		return fmt.Errorf("voting failed. Err: %w", err)
 	}
@@ -143,6 +211,62 @@ func (v *EVMVoter) Execute(m *message.Message) error {
 	return nil
 }
 
+func (v *EVMVoter) isProposalVotedByRelayer(prop *proposal.Proposal) (bool, error) {
+	addresses := v.relayerAddresses
+	if len(addresses) == 0 {
+		addresses = []common.Address{v.client.RelayerAddress()}
+	}
+
+	for _, address := range addresses {
+		voted, err := v.bridgeContract.IsProposalVotedBy(address, prop)
+		if err != nil {
+			return false, err
+		}
+		if voted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// voteAsync sends vote and returns without waiting for it to be mined.
+// Outcome of the vote is logged once it is known.
+func (v *EVMVoter) voteAsync(prop *proposal.Proposal) error {
+	handle, err := v.bridgeContract.VoteProposalAsync(prop, v.voteOptions(prop))
+	if err != nil {
+		log.Error().Err(err).Msgf("voting for proposal %+v failed", prop)
+		return fmt.Errorf("voting failed. Err: %w", err)
+	}
+
+	handle.OnMined(func(receipt *ethereumTypes.Receipt) {
+		log.Debug().Str("hash", receipt.TxHash.String()).Uint64("nonce", prop.DepositNonce).Msgf("Voted")
+	}).OnReverted(func(receipt *ethereumTypes.Receipt) {
+		log.Error().Str("hash", receipt.TxHash.String()).Msgf("Vote for proposal %+v reverted", prop)
+	}).OnDropped(func(err error) {
+		log.Error().Err(err).Msgf("Vote for proposal %+v dropped", prop)
+	})
+
+	log.Debug().Str("hash", handle.Hash().String()).Uint64("nonce", prop.DepositNonce).Msgf("Vote sent")
+	return nil
+}
+
+// voteOptions returns options of the vote transaction. Vote is no longer needed, and can be
+// cancelled if it is not mined in time, once the proposal is passed, executed or canceled.
+func (v *EVMVoter) voteOptions(prop *proposal.Proposal) transactor.TransactOptions {
+	return transactor.TransactOptions{
+		Priority: prop.Metadata.Priority,
+		Obsolete: func() bool {
+			ps, err := v.bridgeContract.ProposalStatus(prop)
+			if err != nil {
+				return false
+			}
+			return ps.Status == message.ProposalStatusPassed ||
+				ps.Status == message.ProposalStatusExecuted ||
+				ps.Status == message.ProposalStatusCanceled
+		},
+	}
+}
+
 // shouldVoteForProposal checks if proposal already has threshold with pending
 // proposal votes from other relayers.
 // Only works properly in conjuction with NewVoterWithSubscription as without a subscription
@@ -179,11 +303,12 @@ func (v *EVMVoter) shouldVoteForProposal(prop *proposal.Proposal, tries int) (bo
 	return true, nil
 }
 
-// repetitiveSimulateVote repeatedly tries(5 times) to simulate vore proposal call until it succeeds
+// repetitiveSimulateVote repeatedly tries(5 times) to simulate vore proposal call until it succeeds.
+// Decoded reverts are not retried as they would fail the same way against the same state.
 func (v *EVMVoter) repetitiveSimulateVote(prop *proposal.Proposal, tries int) error {
 	err := v.bridgeContract.SimulateVoteProposal(prop)
 	if err != nil {
-		if tries < maxSimulateVoteChecks {
+		if tries < maxSimulateVoteChecks && !calls.IsRevert(err) {
 			tries++
 			return v.repetitiveSimulateVote(prop, tries)
 		}
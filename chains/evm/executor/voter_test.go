@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
 	mock_voter "github.com/ChainSafe/chainbridge-core/chains/evm/executor/mock"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
@@ -65,6 +67,67 @@ func (s *VoterTestSuite) TestExecute_SimulateVoteProposalError() {
 	err := s.voter.Execute(&message.Message{})
 
 	s.NotNil(err)
+	s.False(executor.IsDropped(err))
+}
+
+func (s *VoterTestSuite) TestExecute_SimulateVoteProposalRevertedNotRetried() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Times(1).Return(&calls.RevertError{Reason: "proposal already passed"})
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.True(calls.IsRevert(err))
+	s.True(executor.IsDropped(err))
+}
+
+func (s *VoterTestSuite) TestExecute_VoteObsoleteOncePassed() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Times(1).Return(nil)
+	var opts transactor.TransactOptions
+	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).DoAndReturn(func(_ *proposal.Proposal, o transactor.TransactOptions) (*common.Hash, error) {
+		opts = o
+		return &common.Hash{}, nil
+	})
+
+	err := s.voter.Execute(&message.Message{})
+	s.Nil(err)
+
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.False(opts.Obsolete())
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusPassed}, nil)
+	s.True(opts.Obsolete())
+}
+
+func (s *VoterTestSuite) TestExecute_VoteRevertedMessageDropped() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Times(1).Return(nil)
+	s.mockBridgeContract.EXPECT().VoteProposal(gomock.Any(), gomock.Any()).Return(nil, &calls.RevertError{Reason: "proposal already passed"})
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.True(executor.IsDropped(err))
 }
 
 func (s *VoterTestSuite) TestExecute_SimulateVoteProposal() {
@@ -85,6 +148,44 @@ func (s *VoterTestSuite) TestExecute_SimulateVoteProposal() {
 	s.Nil(err)
 }
 
+func (s *VoterTestSuite) TestExecute_AsyncVoting() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Times(1).Return(nil)
+	s.mockBridgeContract.EXPECT().VoteProposalAsync(gomock.Any(), gomock.Any()).Return(transactor.NewTxHandle(common.Hash{}, 0), nil)
+	s.voter.EnableAsyncVoting()
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.Nil(err)
+}
+
+func (s *VoterTestSuite) TestExecute_AsyncVotingNotSupported() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockClient.EXPECT().RelayerAddress().Return(common.Address{})
+
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(gomock.Any(), gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().ProposalStatus(gomock.Any()).Return(message.ProposalStatus{Status: message.ProposalStatusActive}, nil)
+	s.mockBridgeContract.EXPECT().GetThreshold().Return(uint8(1), nil)
+	s.mockBridgeContract.EXPECT().SimulateVoteProposal(gomock.Any()).Times(1).Return(nil)
+	s.mockBridgeContract.EXPECT().VoteProposalAsync(gomock.Any(), gomock.Any()).Return(nil, transactor.ErrAsyncNotSupported)
+	s.voter.EnableAsyncVoting()
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.NotNil(err)
+}
+
 func (s *VoterTestSuite) TestExecute_IsProposalVotedByError() {
 	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
 		Source:       0,
@@ -111,6 +212,20 @@ func (s *VoterTestSuite) TestExecute_ProposalAlreadyVoted() {
 	s.Nil(err)
 }
 
+func (s *VoterTestSuite) TestExecute_ProposalVotedByPoolKey() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
+		Source:       0,
+		DepositNonce: 0,
+	}, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(common.Address{1}, gomock.Any()).Return(false, nil)
+	s.mockBridgeContract.EXPECT().IsProposalVotedBy(common.Address{2}, gomock.Any()).Return(true, nil)
+	s.voter.SetRelayerAddresses([]common.Address{{1}, {2}, {3}})
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.Nil(err)
+}
+
 func (s *VoterTestSuite) TestExecute_ProposalStatusFail() {
 	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(&proposal.Proposal{
 		Source:       0,
@@ -153,3 +268,13 @@ func (s *VoterTestSuite) TestExecute_GetThresholdFail() {
 
 	s.NotNil(err)
 }
+
+func (s *VoterTestSuite) TestExecute_VerificationFailed() {
+	verifier := mock_voter.NewMockMessageVerifier(gomock.NewController(s.T()))
+	s.voter.SetVerifier(verifier)
+	verifier.EXPECT().Verify(gomock.Any()).Return(errors.New("error"))
+
+	err := s.voter.Execute(&message.Message{})
+
+	s.NotNil(err)
+}
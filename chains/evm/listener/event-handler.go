@@ -20,6 +20,15 @@ type DepositHandler interface {
 	HandleDeposit(sourceID, destID uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte) (*message.Message, error)
 }
 
+type BridgeConfigListener interface {
+	FetchBridgeConfigChanges(ctx context.Context, address common.Address, startBlock *big.Int, endBlock *big.Int) ([]events.EventSig, error)
+}
+
+type BridgeCache interface {
+	InvalidateThreshold()
+	InvalidateRelayers()
+}
+
 type DepositEventHandler struct {
 	eventListener  EventListener
 	depositHandler DepositHandler
@@ -58,6 +67,14 @@ func (eh *DepositEventHandler) HandleEvent(startBlock *big.Int, endBlock *big.In
 				return
 			}
 
+			if d.TxHash != (common.Hash{}) {
+				if m.Metadata.Data == nil {
+					m.Metadata.Data = make(map[string]interface{})
+				}
+				m.Metadata.Data[events.DepositTxHashMetadataKey] = d.TxHash
+				m.Metadata.Data[events.DepositBlockNumberMetadataKey] = d.BlockNumber
+			}
+
 			log.Debug().Msgf("Resolved message %+v in block range: %s-%s", m, startBlock.String(), endBlock.String())
 			domainDeposits[m.Destination] = append(domainDeposits[m.Destination], m)
 		}(d)
@@ -69,3 +86,38 @@ func (eh *DepositEventHandler) HandleEvent(startBlock *big.Int, endBlock *big.In
 
 	return nil
 }
+
+// BridgeCacheEventHandler invalidates cached bridge reads when bridge events changing them are emitted
+type BridgeCacheEventHandler struct {
+	configListener BridgeConfigListener
+	cache          BridgeCache
+	bridgeAddress  common.Address
+}
+
+func NewBridgeCacheEventHandler(configListener BridgeConfigListener, cache BridgeCache, bridgeAddress common.Address) *BridgeCacheEventHandler {
+	return &BridgeCacheEventHandler{
+		configListener: configListener,
+		cache:          cache,
+		bridgeAddress:  bridgeAddress,
+	}
+}
+
+func (eh *BridgeCacheEventHandler) HandleEvent(startBlock *big.Int, endBlock *big.Int, msgChan chan []*message.Message) error {
+	changes, err := eh.configListener.FetchBridgeConfigChanges(context.Background(), eh.bridgeAddress, startBlock, endBlock)
+	if err != nil {
+		return fmt.Errorf("unable to fetch bridge config events because of: %+v", err)
+	}
+
+	for _, sig := range changes {
+		switch sig {
+		case events.ThresholdChangedSig:
+			log.Info().Msgf("Relayer threshold changed in block range: %s-%s", startBlock, endBlock)
+			eh.cache.InvalidateThreshold()
+		case events.RelayerAddedSig, events.RelayerRemovedSig:
+			log.Info().Msgf("Relayer set changed in block range: %s-%s", startBlock, endBlock)
+			eh.cache.InvalidateRelayers()
+		}
+	}
+
+	return nil
+}
@@ -184,3 +184,47 @@ func (s *DepositHandlerTestSuite) Test_SuccessfulHandleDeposit() {
 	s.Nil(err)
 	s.Equal(msgs, []*message.Message{{DepositNonce: 1}, {DepositNonce: 2}})
 }
+
+type BridgeCacheEventHandlerTestSuite struct {
+	suite.Suite
+	bridgeCacheEventHandler  *listener.BridgeCacheEventHandler
+	mockBridgeConfigListener *mock_listener.MockBridgeConfigListener
+	mockBridgeCache          *mock_listener.MockBridgeCache
+}
+
+func TestRunBridgeCacheEventHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(BridgeCacheEventHandlerTestSuite))
+}
+
+func (s *BridgeCacheEventHandlerTestSuite) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	s.mockBridgeConfigListener = mock_listener.NewMockBridgeConfigListener(ctrl)
+	s.mockBridgeCache = mock_listener.NewMockBridgeCache(ctrl)
+	s.bridgeCacheEventHandler = listener.NewBridgeCacheEventHandler(s.mockBridgeConfigListener, s.mockBridgeCache, common.Address{})
+}
+
+func (s *BridgeCacheEventHandlerTestSuite) Test_FetchFails() {
+	s.mockBridgeConfigListener.EXPECT().FetchBridgeConfigChanges(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+
+	err := s.bridgeCacheEventHandler.HandleEvent(big.NewInt(0), big.NewInt(5), make(chan []*message.Message))
+
+	s.NotNil(err)
+}
+
+func (s *BridgeCacheEventHandlerTestSuite) Test_NoChanges() {
+	s.mockBridgeConfigListener.EXPECT().FetchBridgeConfigChanges(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]events.EventSig{}, nil)
+
+	err := s.bridgeCacheEventHandler.HandleEvent(big.NewInt(0), big.NewInt(5), make(chan []*message.Message))
+
+	s.Nil(err)
+}
+
+func (s *BridgeCacheEventHandlerTestSuite) Test_ChangedEntriesInvalidated() {
+	s.mockBridgeConfigListener.EXPECT().FetchBridgeConfigChanges(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]events.EventSig{events.ThresholdChangedSig, events.RelayerRemovedSig}, nil)
+	s.mockBridgeCache.EXPECT().InvalidateThreshold()
+	s.mockBridgeCache.EXPECT().InvalidateRelayers()
+
+	err := s.bridgeCacheEventHandler.HandleEvent(big.NewInt(0), big.NewInt(5), make(chan []*message.Message))
+
+	s.Nil(err)
+}
@@ -22,9 +22,15 @@ type ChainClient interface {
 	LatestBlock() (*big.Int, error)
 }
 
+// FinalityClient returns the latest finalized block of chains that support finalized block tag
+type FinalityClient interface {
+	FinalizedBlock() (*big.Int, error)
+}
+
 type EVMListener struct {
-	client        ChainClient
-	eventHandlers []EventHandler
+	client         ChainClient
+	finalityClient FinalityClient
+	eventHandlers  []EventHandler
 
 	domainID           uint8
 	blockstore         *store.BlockStore
@@ -54,6 +60,12 @@ func NewEVMListener(
 	}
 }
 
+// SetFinalityClient makes listener handle events of finalized blocks instead of blocks with
+// block confirmations
+func (l *EVMListener) SetFinalityClient(client FinalityClient) {
+	l.finalityClient = client
+}
+
 // ListenToEvents goes block by block of a network and executes event handlers that are
 // configured for the listener.
 func (l *EVMListener) ListenToEvents(ctx context.Context, startBlock *big.Int, msgChan chan []*message.Message, errChn chan<- error) {
@@ -63,7 +75,7 @@ func (l *EVMListener) ListenToEvents(ctx context.Context, startBlock *big.Int, m
 		case <-ctx.Done():
 			return
 		default:
-			head, err := l.client.LatestBlock()
+			head, confirmations, err := l.head()
 			if err != nil {
 				log.Error().Err(err).Msg("Unable to get latest block")
 				time.Sleep(l.blockRetryInterval)
@@ -75,7 +87,7 @@ func (l *EVMListener) ListenToEvents(ctx context.Context, startBlock *big.Int, m
 			endBlock.Add(startBlock, l.blockInterval)
 
 			// Sleep if the difference is less than needed block confirmations; (latest - current) < BlockDelay
-			if new(big.Int).Sub(head, endBlock).Cmp(l.blockConfirmations) == -1 {
+			if new(big.Int).Sub(head, endBlock).Cmp(confirmations) == -1 {
 				time.Sleep(l.blockRetryInterval)
 				continue
 			}
@@ -98,3 +110,14 @@ func (l *EVMListener) ListenToEvents(ctx context.Context, startBlock *big.Int, m
 		}
 	}
 }
+
+// head returns the head block and the number of confirmations blocks need before their events are
+// handled. Finalized blocks don't need confirmations.
+func (l *EVMListener) head() (*big.Int, *big.Int, error) {
+	if l.finalityClient != nil {
+		head, err := l.finalityClient.FinalizedBlock()
+		return head, big.NewInt(0), err
+	}
+	head, err := l.client.LatestBlock()
+	return head, l.blockConfirmations, err
+}
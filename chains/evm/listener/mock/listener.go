@@ -91,3 +91,88 @@ func (mr *MockDepositHandlerMockRecorder) HandleDeposit(sourceID, destID, nonce,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeposit", reflect.TypeOf((*MockDepositHandler)(nil).HandleDeposit), sourceID, destID, nonce, resourceID, calldata, handlerResponse)
 }
+
+// MockBridgeConfigListener is a mock of BridgeConfigListener interface.
+type MockBridgeConfigListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockBridgeConfigListenerMockRecorder
+}
+
+// MockBridgeConfigListenerMockRecorder is the mock recorder for MockBridgeConfigListener.
+type MockBridgeConfigListenerMockRecorder struct {
+	mock *MockBridgeConfigListener
+}
+
+// NewMockBridgeConfigListener creates a new mock instance.
+func NewMockBridgeConfigListener(ctrl *gomock.Controller) *MockBridgeConfigListener {
+	mock := &MockBridgeConfigListener{ctrl: ctrl}
+	mock.recorder = &MockBridgeConfigListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBridgeConfigListener) EXPECT() *MockBridgeConfigListenerMockRecorder {
+	return m.recorder
+}
+
+// FetchBridgeConfigChanges mocks base method.
+func (m *MockBridgeConfigListener) FetchBridgeConfigChanges(ctx context.Context, address common.Address, startBlock, endBlock *big.Int) ([]events.EventSig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBridgeConfigChanges", ctx, address, startBlock, endBlock)
+	ret0, _ := ret[0].([]events.EventSig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBridgeConfigChanges indicates an expected call of FetchBridgeConfigChanges.
+func (mr *MockBridgeConfigListenerMockRecorder) FetchBridgeConfigChanges(ctx, address, startBlock, endBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBridgeConfigChanges", reflect.TypeOf((*MockBridgeConfigListener)(nil).FetchBridgeConfigChanges), ctx, address, startBlock, endBlock)
+}
+
+// MockBridgeCache is a mock of BridgeCache interface.
+type MockBridgeCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockBridgeCacheMockRecorder
+}
+
+// MockBridgeCacheMockRecorder is the mock recorder for MockBridgeCache.
+type MockBridgeCacheMockRecorder struct {
+	mock *MockBridgeCache
+}
+
+// NewMockBridgeCache creates a new mock instance.
+func NewMockBridgeCache(ctrl *gomock.Controller) *MockBridgeCache {
+	mock := &MockBridgeCache{ctrl: ctrl}
+	mock.recorder = &MockBridgeCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBridgeCache) EXPECT() *MockBridgeCacheMockRecorder {
+	return m.recorder
+}
+
+// InvalidateRelayers mocks base method.
+func (m *MockBridgeCache) InvalidateRelayers() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateRelayers")
+}
+
+// InvalidateRelayers indicates an expected call of InvalidateRelayers.
+func (mr *MockBridgeCacheMockRecorder) InvalidateRelayers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateRelayers", reflect.TypeOf((*MockBridgeCache)(nil).InvalidateRelayers))
+}
+
+// InvalidateThreshold mocks base method.
+func (m *MockBridgeCache) InvalidateThreshold() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InvalidateThreshold")
+}
+
+// InvalidateThreshold indicates an expected call of InvalidateThreshold.
+func (mr *MockBridgeCacheMockRecorder) InvalidateThreshold() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateThreshold", reflect.TypeOf((*MockBridgeCache)(nil).InvalidateThreshold))
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package verifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	ErrMissingDepositLocation = errors.New("message does not contain deposit transaction")
+	ErrUnknownSource          = errors.New("no deposit verifier registered for source domain")
+	ErrMissingHeaderClient    = errors.New("block headers can't be verified without second endpoint")
+)
+
+type ReceiptClient interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*ethTypes.Receipt, error)
+}
+
+type DepositHandler interface {
+	HandleDeposit(sourceID, destID uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte) (*message.Message, error)
+}
+
+type DepositCounter interface {
+	GetDepositCount(destDomainID uint8) (uint64, error)
+}
+
+// SourceVerifier re-fetches deposits made on a single source chain
+// through a separately configured endpoint.
+type SourceVerifier struct {
+	client         ReceiptClient
+	depositHandler DepositHandler
+	depositCounter DepositCounter
+	bridgeAddress  common.Address
+	abi            abi.ABI
+}
+
+// NewSourceVerifier creates an instance of SourceVerifier. Client, deposit handler and
+// deposit counter should all use the secondary endpoint of the source chain.
+func NewSourceVerifier(client ReceiptClient, depositHandler DepositHandler, depositCounter DepositCounter, bridgeAddress common.Address) *SourceVerifier {
+	a, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	return &SourceVerifier{
+		client:         client,
+		depositHandler: depositHandler,
+		depositCounter: depositCounter,
+		bridgeAddress:  bridgeAddress,
+		abi:            a,
+	}
+}
+
+// FetchMessage fetches deposit transaction receipt of the message, finds deposit log in it,
+// checks it against bridge deposit count and resolves message from it.
+func (v *SourceVerifier) FetchMessage(m *message.Message) (*message.Message, error) {
+	txHash, blockNumber, err := depositLocation(m)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := v.client.TransactionReceipt(context.TODO(), txHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch deposit receipt %s: %w", txHash, err)
+	}
+	if receipt.Status != ethTypes.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("deposit transaction %s failed", txHash)
+	}
+	if receipt.BlockNumber == nil || receipt.BlockNumber.Uint64() != blockNumber {
+		return nil, fmt.Errorf("deposit transaction %s included in block %v instead of %d", txHash, receipt.BlockNumber, blockNumber)
+	}
+
+	deposit, err := findDeposit(v.abi, v.bridgeAddress, receipt.Logs, m)
+	if err != nil {
+		return nil, fmt.Errorf("%w in transaction %s", err, txHash)
+	}
+
+	depositCount, err := v.depositCounter.GetDepositCount(deposit.DestinationDomainID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch deposit count: %w", err)
+	}
+	if depositCount < deposit.DepositNonce {
+		return nil, fmt.Errorf("bridge has %d deposits to domain %d but deposit has nonce %d", depositCount, deposit.DestinationDomainID, deposit.DepositNonce)
+	}
+
+	verifiedMessage, err := v.depositHandler.HandleDeposit(m.Source, deposit.DestinationDomainID, deposit.DepositNonce, deposit.ResourceID, deposit.Data, deposit.HandlerResponse)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve verified deposit: %w", err)
+	}
+	return verifiedMessage, nil
+}
+
+// findDeposit returns deposit event emitted by the bridge
+// with the destination and nonce of the message
+func findDeposit(bridgeABI abi.ABI, bridgeAddress common.Address, logs []*ethTypes.Log, m *message.Message) (*events.Deposit, error) {
+	for _, l := range logs {
+		if l.Address != bridgeAddress || len(l.Topics) == 0 || l.Topics[0] != events.DepositSig.GetTopic() || l.Removed {
+			continue
+		}
+
+		var deposit events.Deposit
+		err := bridgeABI.UnpackIntoInterface(&deposit, "Deposit", l.Data)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Failed unpacking deposit log")
+			continue
+		}
+
+		if deposit.DepositNonce == m.DepositNonce && deposit.DestinationDomainID == m.Destination {
+			return &deposit, nil
+		}
+	}
+	return nil, fmt.Errorf("deposit %d to domain %d not found", m.DepositNonce, m.Destination)
+}
+
+type MessageFetcher interface {
+	FetchMessage(m *message.Message) (*message.Message, error)
+}
+
+// DepositVerifier verifies messages against deposits re-fetched from
+// the message source chain
+type DepositVerifier struct {
+	sources           map[uint8]MessageFetcher
+	messageProcessors []message.MessageProcessor
+}
+
+// NewDepositVerifier creates an instance of DepositVerifier. Message processors
+// should be the same ones relayer applies to messages before they are routed to destination.
+func NewDepositVerifier(messageProcessors ...message.MessageProcessor) *DepositVerifier {
+	return &DepositVerifier{
+		sources:           make(map[uint8]MessageFetcher),
+		messageProcessors: messageProcessors,
+	}
+}
+
+// RegisterSource registers message fetcher that independently fetches deposits of the domain
+func (v *DepositVerifier) RegisterSource(domainID uint8, fetcher MessageFetcher) {
+	log.Info().Msgf("Registered deposit verifier for domain %d", domainID)
+	v.sources[domainID] = fetcher
+}
+
+// Enabled returns true if at least one source verifier is registered
+func (v *DepositVerifier) Enabled() bool {
+	return len(v.sources) > 0
+}
+
+// Verify refuses messages from sources without registered verifier and messages
+// that do not match deposits fetched from the secondary endpoint
+func (v *DepositVerifier) Verify(m *message.Message) error {
+	fetcher, ok := v.sources[m.Source]
+	if !ok {
+		return fmt.Errorf("%w %d", ErrUnknownSource, m.Source)
+	}
+
+	verifiedMessage, err := fetcher.FetchMessage(m)
+	if err != nil {
+		return err
+	}
+	for _, mp := range v.messageProcessors {
+		if err := mp(verifiedMessage); err != nil {
+			return fmt.Errorf("error %w processing verified message %v", err, verifiedMessage)
+		}
+	}
+
+	if !messagesMatch(m, verifiedMessage) {
+		return fmt.Errorf("message %+v does not match verified deposit %+v", m, verifiedMessage)
+	}
+	return nil
+}
+
+func depositLocation(m *message.Message) (common.Hash, uint64, error) {
+	txHash, ok := m.Metadata.Data[events.DepositTxHashMetadataKey].(common.Hash)
+	if !ok {
+		return common.Hash{}, 0, ErrMissingDepositLocation
+	}
+	blockNumber, ok := m.Metadata.Data[events.DepositBlockNumberMetadataKey].(uint64)
+	if !ok {
+		return common.Hash{}, 0, ErrMissingDepositLocation
+	}
+	return txHash, blockNumber, nil
+}
+
+// messagesMatch compares fields of messages resolved from deposit data.
+// Metadata data is ignored as it is filled by listener
+func messagesMatch(m, verified *message.Message) bool {
+	return m.Source == verified.Source &&
+		m.Destination == verified.Destination &&
+		m.DepositNonce == verified.DepositNonce &&
+		m.ResourceId == verified.ResourceId &&
+		m.Type == verified.Type &&
+		m.Metadata.Priority == verified.Metadata.Priority &&
+		reflect.DeepEqual(m.Payload, verified.Payload)
+}
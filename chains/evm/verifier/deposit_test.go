@@ -0,0 +1,170 @@
+package verifier_test
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/verifier"
+	mock_verifier "github.com/ChainSafe/chainbridge-core/chains/evm/verifier/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ChainSafe/chainbridge-core/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	bridgeAddress = common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+	txHash        = common.HexToHash("0xf25ed4a14bf7ad20354b46fe38d7d4525f2ea3042db9a9954ef8d73c558b500c")
+	resourceID    = types.ResourceID{1}
+	depositData   = []byte{1, 2, 3}
+)
+
+type DepositVerifierTestSuite struct {
+	suite.Suite
+	depositVerifier    *verifier.DepositVerifier
+	mockReceiptClient  *mock_verifier.MockReceiptClient
+	mockDepositHandler *mock_verifier.MockDepositHandler
+	mockDepositCounter *mock_verifier.MockDepositCounter
+	message            *message.Message
+	receipt            *ethTypes.Receipt
+}
+
+func TestRunDepositVerifierTestSuite(t *testing.T) {
+	suite.Run(t, new(DepositVerifierTestSuite))
+}
+
+func (s *DepositVerifierTestSuite) SetupSuite()    {}
+func (s *DepositVerifierTestSuite) TearDownSuite() {}
+func (s *DepositVerifierTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockReceiptClient = mock_verifier.NewMockReceiptClient(gomockController)
+	s.mockDepositHandler = mock_verifier.NewMockDepositHandler(gomockController)
+	s.mockDepositCounter = mock_verifier.NewMockDepositCounter(gomockController)
+	s.depositVerifier = verifier.NewDepositVerifier()
+	s.depositVerifier.RegisterSource(1, verifier.NewSourceVerifier(s.mockReceiptClient, s.mockDepositHandler, s.mockDepositCounter, bridgeAddress))
+
+	s.message = message.NewMessage(1, 2, 3, resourceID, message.GenericTransfer, []interface{}{depositData}, message.Metadata{
+		Data: map[string]interface{}{
+			events.DepositTxHashMetadataKey:      txHash,
+			events.DepositBlockNumberMetadataKey: uint64(10),
+		},
+	})
+	s.receipt = &ethTypes.Receipt{
+		Status:      ethTypes.ReceiptStatusSuccessful,
+		TxHash:      txHash,
+		BlockNumber: big.NewInt(10),
+		Logs:        []*ethTypes.Log{depositLog(2, 3)},
+	}
+}
+func (s *DepositVerifierTestSuite) TearDownTest() {}
+
+func depositLog(destination uint8, nonce uint64) *ethTypes.Log {
+	a, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	data, _ := a.Events["Deposit"].Inputs.NonIndexed().Pack(destination, resourceID, nonce, depositData, []byte{})
+	return &ethTypes.Log{
+		Address: bridgeAddress,
+		Topics:  []common.Hash{events.DepositSig.GetTopic(), common.HexToHash("0x1")},
+		Data:    data,
+		TxHash:  txHash,
+	}
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_UnknownSource() {
+	s.message.Source = 5
+
+	err := s.depositVerifier.Verify(s.message)
+
+	s.True(errors.Is(err, verifier.ErrUnknownSource))
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_MissingDepositLocation() {
+	s.message.Metadata.Data = nil
+
+	err := s.depositVerifier.Verify(s.message)
+
+	s.True(errors.Is(err, verifier.ErrMissingDepositLocation))
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_FailedTransaction() {
+	s.receipt.Status = ethTypes.ReceiptStatusFailed
+	s.mockReceiptClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(s.receipt, nil)
+
+	err := s.depositVerifier.Verify(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_DifferentBlock() {
+	s.receipt.BlockNumber = big.NewInt(11)
+	s.mockReceiptClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(s.receipt, nil)
+
+	err := s.depositVerifier.Verify(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_DepositLogMissing() {
+	s.receipt.Logs = []*ethTypes.Log{depositLog(2, 4)}
+	s.mockReceiptClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(s.receipt, nil)
+
+	err := s.depositVerifier.Verify(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_DepositCountTooLow() {
+	s.mockReceiptClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(s.receipt, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCount(uint8(2)).Return(uint64(2), nil)
+
+	err := s.depositVerifier.Verify(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_MessageMismatch() {
+	s.mockReceiptClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(s.receipt, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCount(uint8(2)).Return(uint64(3), nil)
+	s.mockDepositHandler.EXPECT().HandleDeposit(uint8(1), uint8(2), uint64(3), resourceID, depositData, []byte{}).Return(
+		message.NewMessage(1, 2, 3, resourceID, message.GenericTransfer, []interface{}{[]byte{4}}, message.Metadata{}), nil,
+	)
+
+	err := s.depositVerifier.Verify(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_MessageProcessorApplied() {
+	s.depositVerifier = verifier.NewDepositVerifier(func(m *message.Message) error {
+		m.Payload = []interface{}{depositData}
+		return nil
+	})
+	s.depositVerifier.RegisterSource(1, verifier.NewSourceVerifier(s.mockReceiptClient, s.mockDepositHandler, s.mockDepositCounter, bridgeAddress))
+	s.mockReceiptClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(s.receipt, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCount(uint8(2)).Return(uint64(3), nil)
+	s.mockDepositHandler.EXPECT().HandleDeposit(uint8(1), uint8(2), uint64(3), resourceID, depositData, []byte{}).Return(
+		message.NewMessage(1, 2, 3, resourceID, message.GenericTransfer, []interface{}{[]byte{4}}, message.Metadata{}), nil,
+	)
+
+	err := s.depositVerifier.Verify(s.message)
+
+	s.Nil(err)
+}
+
+func (s *DepositVerifierTestSuite) TestVerify_Success() {
+	s.mockReceiptClient.EXPECT().TransactionReceipt(gomock.Any(), txHash).Return(s.receipt, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCount(uint8(2)).Return(uint64(3), nil)
+	s.mockDepositHandler.EXPECT().HandleDeposit(uint8(1), uint8(2), uint64(3), resourceID, depositData, []byte{}).Return(
+		message.NewMessage(1, 2, 3, resourceID, message.GenericTransfer, []interface{}{depositData}, message.Metadata{}), nil,
+	)
+
+	err := s.depositVerifier.Verify(s.message)
+
+	s.Nil(err)
+}
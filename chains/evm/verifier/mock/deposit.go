@@ -0,0 +1,168 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/verifier/deposit.go
+
+// Package mock_verifier is a generated GoMock package.
+package mock_verifier
+
+import (
+	context "context"
+	reflect "reflect"
+
+	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	types "github.com/ChainSafe/chainbridge-core/types"
+	common "github.com/ethereum/go-ethereum/common"
+	types0 "github.com/ethereum/go-ethereum/core/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReceiptClient is a mock of ReceiptClient interface.
+type MockReceiptClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceiptClientMockRecorder
+}
+
+// MockReceiptClientMockRecorder is the mock recorder for MockReceiptClient.
+type MockReceiptClientMockRecorder struct {
+	mock *MockReceiptClient
+}
+
+// NewMockReceiptClient creates a new mock instance.
+func NewMockReceiptClient(ctrl *gomock.Controller) *MockReceiptClient {
+	mock := &MockReceiptClient{ctrl: ctrl}
+	mock.recorder = &MockReceiptClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceiptClient) EXPECT() *MockReceiptClientMockRecorder {
+	return m.recorder
+}
+
+// TransactionReceipt mocks base method.
+func (m *MockReceiptClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types0.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionReceipt", ctx, txHash)
+	ret0, _ := ret[0].(*types0.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionReceipt indicates an expected call of TransactionReceipt.
+func (mr *MockReceiptClientMockRecorder) TransactionReceipt(ctx, txHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionReceipt", reflect.TypeOf((*MockReceiptClient)(nil).TransactionReceipt), ctx, txHash)
+}
+
+// MockDepositHandler is a mock of DepositHandler interface.
+type MockDepositHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositHandlerMockRecorder
+}
+
+// MockDepositHandlerMockRecorder is the mock recorder for MockDepositHandler.
+type MockDepositHandlerMockRecorder struct {
+	mock *MockDepositHandler
+}
+
+// NewMockDepositHandler creates a new mock instance.
+func NewMockDepositHandler(ctrl *gomock.Controller) *MockDepositHandler {
+	mock := &MockDepositHandler{ctrl: ctrl}
+	mock.recorder = &MockDepositHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositHandler) EXPECT() *MockDepositHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleDeposit mocks base method.
+func (m *MockDepositHandler) HandleDeposit(sourceID, destID uint8, nonce uint64, resourceID types.ResourceID, calldata, handlerResponse []byte) (*message.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleDeposit", sourceID, destID, nonce, resourceID, calldata, handlerResponse)
+	ret0, _ := ret[0].(*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleDeposit indicates an expected call of HandleDeposit.
+func (mr *MockDepositHandlerMockRecorder) HandleDeposit(sourceID, destID, nonce, resourceID, calldata, handlerResponse interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDeposit", reflect.TypeOf((*MockDepositHandler)(nil).HandleDeposit), sourceID, destID, nonce, resourceID, calldata, handlerResponse)
+}
+
+// MockDepositCounter is a mock of DepositCounter interface.
+type MockDepositCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositCounterMockRecorder
+}
+
+// MockDepositCounterMockRecorder is the mock recorder for MockDepositCounter.
+type MockDepositCounterMockRecorder struct {
+	mock *MockDepositCounter
+}
+
+// NewMockDepositCounter creates a new mock instance.
+func NewMockDepositCounter(ctrl *gomock.Controller) *MockDepositCounter {
+	mock := &MockDepositCounter{ctrl: ctrl}
+	mock.recorder = &MockDepositCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositCounter) EXPECT() *MockDepositCounterMockRecorder {
+	return m.recorder
+}
+
+// GetDepositCount mocks base method.
+func (m *MockDepositCounter) GetDepositCount(destDomainID uint8) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositCount", destDomainID)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositCount indicates an expected call of GetDepositCount.
+func (mr *MockDepositCounterMockRecorder) GetDepositCount(destDomainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositCount", reflect.TypeOf((*MockDepositCounter)(nil).GetDepositCount), destDomainID)
+}
+
+// MockMessageFetcher is a mock of MessageFetcher interface.
+type MockMessageFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageFetcherMockRecorder
+}
+
+// MockMessageFetcherMockRecorder is the mock recorder for MockMessageFetcher.
+type MockMessageFetcherMockRecorder struct {
+	mock *MockMessageFetcher
+}
+
+// NewMockMessageFetcher creates a new mock instance.
+func NewMockMessageFetcher(ctrl *gomock.Controller) *MockMessageFetcher {
+	mock := &MockMessageFetcher{ctrl: ctrl}
+	mock.recorder = &MockMessageFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageFetcher) EXPECT() *MockMessageFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchMessage mocks base method.
+func (m_2 *MockMessageFetcher) FetchMessage(m *message.Message) (*message.Message, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "FetchMessage", m)
+	ret0, _ := ret[0].(*message.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchMessage indicates an expected call of FetchMessage.
+func (mr *MockMessageFetcherMockRecorder) FetchMessage(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchMessage", reflect.TypeOf((*MockMessageFetcher)(nil).FetchMessage), m)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/verifier/receipts.go
+
+// Package mock_verifier is a generated GoMock package.
+package mock_verifier
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockHeaderClient is a mock of HeaderClient interface.
+type MockHeaderClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHeaderClientMockRecorder
+}
+
+// MockHeaderClientMockRecorder is the mock recorder for MockHeaderClient.
+type MockHeaderClientMockRecorder struct {
+	mock *MockHeaderClient
+}
+
+// NewMockHeaderClient creates a new mock instance.
+func NewMockHeaderClient(ctrl *gomock.Controller) *MockHeaderClient {
+	mock := &MockHeaderClient{ctrl: ctrl}
+	mock.recorder = &MockHeaderClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHeaderClient) EXPECT() *MockHeaderClientMockRecorder {
+	return m.recorder
+}
+
+// HeaderByNumber mocks base method.
+func (m *MockHeaderClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeaderByNumber", ctx, number)
+	ret0, _ := ret[0].(*types.Header)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeaderByNumber indicates an expected call of HeaderByNumber.
+func (mr *MockHeaderClientMockRecorder) HeaderByNumber(ctx, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeaderByNumber", reflect.TypeOf((*MockHeaderClient)(nil).HeaderByNumber), ctx, number)
+}
+
+// MockBlockReceiptsClient is a mock of BlockReceiptsClient interface.
+type MockBlockReceiptsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockReceiptsClientMockRecorder
+}
+
+// MockBlockReceiptsClientMockRecorder is the mock recorder for MockBlockReceiptsClient.
+type MockBlockReceiptsClientMockRecorder struct {
+	mock *MockBlockReceiptsClient
+}
+
+// NewMockBlockReceiptsClient creates a new mock instance.
+func NewMockBlockReceiptsClient(ctrl *gomock.Controller) *MockBlockReceiptsClient {
+	mock := &MockBlockReceiptsClient{ctrl: ctrl}
+	mock.recorder = &MockBlockReceiptsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockReceiptsClient) EXPECT() *MockBlockReceiptsClientMockRecorder {
+	return m.recorder
+}
+
+// BlockReceipts mocks base method.
+func (m *MockBlockReceiptsClient) BlockReceipts(ctx context.Context, blockNumber *big.Int) ([]*types.Receipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockReceipts", ctx, blockNumber)
+	ret0, _ := ret[0].([]*types.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockReceipts indicates an expected call of BlockReceipts.
+func (mr *MockBlockReceiptsClientMockRecorder) BlockReceipts(ctx, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockReceipts", reflect.TypeOf((*MockBlockReceiptsClient)(nil).BlockReceipts), ctx, blockNumber)
+}
+
+// HeaderByNumber mocks base method.
+func (m *MockBlockReceiptsClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeaderByNumber", ctx, number)
+	ret0, _ := ret[0].(*types.Header)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeaderByNumber indicates an expected call of HeaderByNumber.
+func (mr *MockBlockReceiptsClientMockRecorder) HeaderByNumber(ctx, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeaderByNumber", reflect.TypeOf((*MockBlockReceiptsClient)(nil).HeaderByNumber), ctx, number)
+}
@@ -0,0 +1,163 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+type HeaderClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethTypes.Header, error)
+}
+
+type BlockReceiptsClient interface {
+	HeaderClient
+	BlockReceipts(ctx context.Context, blockNumber *big.Int) ([]*ethTypes.Receipt, error)
+}
+
+// ReceiptProofVerifier verifies that deposit log is included in the block it was
+// reported in by rebuilding receipts trie of the block and proving deposit receipt
+// against the block header receipts root.
+type ReceiptProofVerifier struct {
+	client         BlockReceiptsClient
+	headerClient   HeaderClient
+	depositHandler DepositHandler
+	bridgeAddress  common.Address
+	abi            abi.ABI
+}
+
+// NewReceiptProofVerifier creates an instance of ReceiptProofVerifier. Block header hash is compared
+// with the header fetched through headerClient from a second endpoint, as the header fetched from the
+// same endpoint as receipts proves nothing if the endpoint lies. Without headerClient every message fails.
+func NewReceiptProofVerifier(client BlockReceiptsClient, headerClient HeaderClient, depositHandler DepositHandler, bridgeAddress common.Address) *ReceiptProofVerifier {
+	a, _ := abi.JSON(strings.NewReader(consts.BridgeABI))
+	return &ReceiptProofVerifier{
+		client:         client,
+		headerClient:   headerClient,
+		depositHandler: depositHandler,
+		bridgeAddress:  bridgeAddress,
+		abi:            a,
+	}
+}
+
+// FetchMessage proves inclusion of the message deposit receipt in the block and
+// resolves message from the proven deposit log.
+func (v *ReceiptProofVerifier) FetchMessage(m *message.Message) (*message.Message, error) {
+	txHash, blockNumber, err := depositLocation(m)
+	if err != nil {
+		return nil, err
+	}
+	number := new(big.Int).SetUint64(blockNumber)
+
+	header, err := v.client.HeaderByNumber(context.TODO(), number)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch header of block %d: %w", blockNumber, err)
+	}
+	err = v.verifyHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := v.client.BlockReceipts(context.TODO(), number)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch receipts of block %d: %w", blockNumber, err)
+	}
+
+	receipt, err := ProveReceipt(header, receipts, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != ethTypes.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("deposit transaction %s failed", txHash)
+	}
+
+	deposit, err := findDeposit(v.abi, v.bridgeAddress, receipt.Logs, m)
+	if err != nil {
+		return nil, fmt.Errorf("%w in proven receipt of transaction %s", err, txHash)
+	}
+
+	verifiedMessage, err := v.depositHandler.HandleDeposit(m.Source, deposit.DestinationDomainID, deposit.DepositNonce, deposit.ResourceID, deposit.Data, deposit.HandlerResponse)
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve proven deposit: %w", err)
+	}
+	return verifiedMessage, nil
+}
+
+// verifyHeader compares header hash with the hash of the header at the same
+// height fetched from the second endpoint
+func (v *ReceiptProofVerifier) verifyHeader(header *ethTypes.Header) error {
+	if v.headerClient == nil {
+		return ErrMissingHeaderClient
+	}
+	secondHeader, err := v.headerClient.HeaderByNumber(context.TODO(), header.Number)
+	if err != nil {
+		return fmt.Errorf("unable to fetch header of block %s from second endpoint: %w", header.Number, err)
+	}
+	if secondHeader.Hash() != header.Hash() {
+		return fmt.Errorf("header hash %s of block %s does not match second endpoint header hash %s", header.Hash(), header.Number, secondHeader.Hash())
+	}
+	return nil
+}
+
+// ProveReceipt rebuilds receipts trie from all receipts of the block, checks its root against
+// header ReceiptHash and returns receipt of the transaction decoded from a merkle proof of its inclusion.
+// Returned receipt contains only consensus fields.
+func ProveReceipt(header *ethTypes.Header, receipts []*ethTypes.Receipt, txHash common.Hash) (*ethTypes.Receipt, error) {
+	txIndex := -1
+	for i, r := range receipts {
+		if r.TxHash == txHash {
+			txIndex = i
+			break
+		}
+	}
+	if txIndex == -1 {
+		return nil, fmt.Errorf("receipt of transaction %s not found in block %s", txHash, header.Number)
+	}
+
+	receiptsTrie, err := trie.New(common.Hash{}, trie.NewDatabase(memorydb.New()))
+	if err != nil {
+		return nil, err
+	}
+	list := ethTypes.Receipts(receipts)
+	valueBuf := new(bytes.Buffer)
+	for i := range list {
+		valueBuf.Reset()
+		list.EncodeIndex(i, valueBuf)
+		receiptsTrie.Update(rlp.AppendUint64(nil, uint64(i)), common.CopyBytes(valueBuf.Bytes()))
+	}
+	if receiptsTrie.Hash() != header.ReceiptHash {
+		return nil, fmt.Errorf("receipts root %s does not match header receipts root %s of block %s", receiptsTrie.Hash(), header.ReceiptHash, header.Number)
+	}
+
+	key := rlp.AppendUint64(nil, uint64(txIndex))
+	proof := memorydb.New()
+	err = receiptsTrie.Prove(key, 0, proof)
+	if err != nil {
+		return nil, err
+	}
+	value, err := trie.VerifyProof(header.ReceiptHash, key, proof)
+	if err != nil {
+		return nil, fmt.Errorf("invalid receipt proof of transaction %s: %w", txHash, err)
+	}
+
+	receipt := new(ethTypes.Receipt)
+	err = receipt.UnmarshalBinary(value)
+	if err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
@@ -0,0 +1,151 @@
+package verifier_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/verifier"
+	mock_verifier "github.com/ChainSafe/chainbridge-core/chains/evm/verifier/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReceiptProofVerifierTestSuite struct {
+	suite.Suite
+	receiptProofVerifier *verifier.ReceiptProofVerifier
+	mockClient           *mock_verifier.MockBlockReceiptsClient
+	mockHeaderClient     *mock_verifier.MockHeaderClient
+	mockDepositHandler   *mock_verifier.MockDepositHandler
+	message              *message.Message
+	receipts             []*ethTypes.Receipt
+	header               *ethTypes.Header
+}
+
+func TestRunReceiptProofVerifierTestSuite(t *testing.T) {
+	suite.Run(t, new(ReceiptProofVerifierTestSuite))
+}
+
+func (s *ReceiptProofVerifierTestSuite) SetupSuite()    {}
+func (s *ReceiptProofVerifierTestSuite) TearDownSuite() {}
+func (s *ReceiptProofVerifierTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockClient = mock_verifier.NewMockBlockReceiptsClient(gomockController)
+	s.mockHeaderClient = mock_verifier.NewMockHeaderClient(gomockController)
+	s.mockDepositHandler = mock_verifier.NewMockDepositHandler(gomockController)
+	s.receiptProofVerifier = verifier.NewReceiptProofVerifier(s.mockClient, s.mockHeaderClient, s.mockDepositHandler, bridgeAddress)
+
+	s.message = message.NewMessage(1, 2, 3, resourceID, message.GenericTransfer, []interface{}{depositData}, message.Metadata{
+		Data: map[string]interface{}{
+			events.DepositTxHashMetadataKey:      txHash,
+			events.DepositBlockNumberMetadataKey: uint64(10),
+		},
+	})
+	s.receipts = []*ethTypes.Receipt{
+		{
+			Status:            ethTypes.ReceiptStatusSuccessful,
+			TxHash:            common.HexToHash("0x1"),
+			CumulativeGasUsed: 21000,
+			Logs:              []*ethTypes.Log{},
+		},
+		{
+			Status:            ethTypes.ReceiptStatusSuccessful,
+			TxHash:            txHash,
+			CumulativeGasUsed: 100000,
+			Logs:              []*ethTypes.Log{depositLog(2, 3)},
+		},
+		{
+			Type:              ethTypes.DynamicFeeTxType,
+			Status:            ethTypes.ReceiptStatusFailed,
+			TxHash:            common.HexToHash("0x2"),
+			CumulativeGasUsed: 150000,
+			Logs:              []*ethTypes.Log{},
+		},
+	}
+	s.header = &ethTypes.Header{
+		Number:      big.NewInt(10),
+		Difficulty:  big.NewInt(1),
+		ReceiptHash: ethTypes.DeriveSha(ethTypes.Receipts(s.receipts), trie.NewStackTrie(nil)),
+	}
+}
+func (s *ReceiptProofVerifierTestSuite) TearDownTest() {}
+
+func (s *ReceiptProofVerifierTestSuite) TestFetchMessage_MissingDepositLocation() {
+	s.message.Metadata.Data = nil
+
+	_, err := s.receiptProofVerifier.FetchMessage(s.message)
+
+	s.True(errors.Is(err, verifier.ErrMissingDepositLocation))
+}
+
+func (s *ReceiptProofVerifierTestSuite) TestFetchMessage_HeaderMismatch() {
+	s.mockClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+	s.mockHeaderClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(&ethTypes.Header{
+		Number:     big.NewInt(10),
+		Difficulty: big.NewInt(2),
+	}, nil)
+
+	_, err := s.receiptProofVerifier.FetchMessage(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptProofVerifierTestSuite) TestFetchMessage_ReceiptsRootMismatch() {
+	s.receipts[0].Status = ethTypes.ReceiptStatusFailed
+	s.mockClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+	s.mockHeaderClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+	s.mockClient.EXPECT().BlockReceipts(gomock.Any(), big.NewInt(10)).Return(s.receipts, nil)
+
+	_, err := s.receiptProofVerifier.FetchMessage(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptProofVerifierTestSuite) TestFetchMessage_ReceiptNotInBlock() {
+	s.receipts = s.receipts[:1]
+	s.mockClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+	s.mockHeaderClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+	s.mockClient.EXPECT().BlockReceipts(gomock.Any(), big.NewInt(10)).Return(s.receipts, nil)
+
+	_, err := s.receiptProofVerifier.FetchMessage(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptProofVerifierTestSuite) TestFetchMessage_DepositLogMissing() {
+	s.message.DepositNonce = 4
+	s.mockClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+	s.mockHeaderClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+	s.mockClient.EXPECT().BlockReceipts(gomock.Any(), big.NewInt(10)).Return(s.receipts, nil)
+
+	_, err := s.receiptProofVerifier.FetchMessage(s.message)
+
+	s.NotNil(err)
+}
+
+func (s *ReceiptProofVerifierTestSuite) TestFetchMessage_Success() {
+	expectedMessage := message.NewMessage(1, 2, 3, resourceID, message.GenericTransfer, []interface{}{depositData}, message.Metadata{})
+	s.mockClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+	s.mockHeaderClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+	s.mockClient.EXPECT().BlockReceipts(gomock.Any(), big.NewInt(10)).Return(s.receipts, nil)
+	s.mockDepositHandler.EXPECT().HandleDeposit(uint8(1), uint8(2), uint64(3), resourceID, depositData, []byte{}).Return(expectedMessage, nil)
+
+	m, err := s.receiptProofVerifier.FetchMessage(s.message)
+
+	s.Nil(err)
+	s.Equal(expectedMessage, m)
+}
+
+func (s *ReceiptProofVerifierTestSuite) TestFetchMessage_WithoutHeaderClient() {
+	s.receiptProofVerifier = verifier.NewReceiptProofVerifier(s.mockClient, nil, s.mockDepositHandler, bridgeAddress)
+	s.mockClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(10)).Return(s.header, nil)
+
+	_, err := s.receiptProofVerifier.FetchMessage(s.message)
+
+	s.True(errors.Is(err, verifier.ErrMissingHeaderClient))
+}
@@ -0,0 +1,299 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./chains/evm/watcher/watcher.go
+
+// Package mock_watcher is a generated GoMock package.
+package mock_watcher
+
+import (
+	context "context"
+	big "math/big"
+	reflect "reflect"
+
+	events "github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	transactor "github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	proposal "github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
+	watcher "github.com/ChainSafe/chainbridge-core/chains/evm/watcher"
+	message "github.com/ChainSafe/chainbridge-core/relayer/message"
+	common "github.com/ethereum/go-ethereum/common"
+	types "github.com/ethereum/go-ethereum/core/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMessageHandler is a mock of MessageHandler interface.
+type MockMessageHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageHandlerMockRecorder
+}
+
+// MockMessageHandlerMockRecorder is the mock recorder for MockMessageHandler.
+type MockMessageHandlerMockRecorder struct {
+	mock *MockMessageHandler
+}
+
+// NewMockMessageHandler creates a new mock instance.
+func NewMockMessageHandler(ctrl *gomock.Controller) *MockMessageHandler {
+	mock := &MockMessageHandler{ctrl: ctrl}
+	mock.recorder = &MockMessageHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageHandler) EXPECT() *MockMessageHandlerMockRecorder {
+	return m.recorder
+}
+
+// HandleMessage mocks base method.
+func (m_2 *MockMessageHandler) HandleMessage(m *message.Message) (*proposal.Proposal, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "HandleMessage", m)
+	ret0, _ := ret[0].(*proposal.Proposal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HandleMessage indicates an expected call of HandleMessage.
+func (mr *MockMessageHandlerMockRecorder) HandleMessage(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleMessage", reflect.TypeOf((*MockMessageHandler)(nil).HandleMessage), m)
+}
+
+// MockVoteListener is a mock of VoteListener interface.
+type MockVoteListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockVoteListenerMockRecorder
+}
+
+// MockVoteListenerMockRecorder is the mock recorder for MockVoteListener.
+type MockVoteListenerMockRecorder struct {
+	mock *MockVoteListener
+}
+
+// NewMockVoteListener creates a new mock instance.
+func NewMockVoteListener(ctrl *gomock.Controller) *MockVoteListener {
+	mock := &MockVoteListener{ctrl: ctrl}
+	mock.recorder = &MockVoteListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVoteListener) EXPECT() *MockVoteListenerMockRecorder {
+	return m.recorder
+}
+
+// FetchProposalVotes mocks base method.
+func (m *MockVoteListener) FetchProposalVotes(ctx context.Context, address common.Address, startBlock, endBlock *big.Int) ([]*events.ProposalVote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchProposalVotes", ctx, address, startBlock, endBlock)
+	ret0, _ := ret[0].([]*events.ProposalVote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchProposalVotes indicates an expected call of FetchProposalVotes.
+func (mr *MockVoteListenerMockRecorder) FetchProposalVotes(ctx, address, startBlock, endBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchProposalVotes", reflect.TypeOf((*MockVoteListener)(nil).FetchProposalVotes), ctx, address, startBlock, endBlock)
+}
+
+// FetchUnpaused mocks base method.
+func (m *MockVoteListener) FetchUnpaused(ctx context.Context, address common.Address, startBlock, endBlock *big.Int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUnpaused", ctx, address, startBlock, endBlock)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUnpaused indicates an expected call of FetchUnpaused.
+func (mr *MockVoteListenerMockRecorder) FetchUnpaused(ctx, address, startBlock, endBlock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUnpaused", reflect.TypeOf((*MockVoteListener)(nil).FetchUnpaused), ctx, address, startBlock, endBlock)
+}
+
+// MockTransactionClient is a mock of TransactionClient interface.
+type MockTransactionClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionClientMockRecorder
+}
+
+// MockTransactionClientMockRecorder is the mock recorder for MockTransactionClient.
+type MockTransactionClientMockRecorder struct {
+	mock *MockTransactionClient
+}
+
+// NewMockTransactionClient creates a new mock instance.
+func NewMockTransactionClient(ctrl *gomock.Controller) *MockTransactionClient {
+	mock := &MockTransactionClient{ctrl: ctrl}
+	mock.recorder = &MockTransactionClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionClient) EXPECT() *MockTransactionClientMockRecorder {
+	return m.recorder
+}
+
+// TransactionByHash mocks base method.
+func (m *MockTransactionClient) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionByHash", ctx, hash)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TransactionByHash indicates an expected call of TransactionByHash.
+func (mr *MockTransactionClientMockRecorder) TransactionByHash(ctx, hash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionByHash", reflect.TypeOf((*MockTransactionClient)(nil).TransactionByHash), ctx, hash)
+}
+
+// MockDepositCounter is a mock of DepositCounter interface.
+type MockDepositCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDepositCounterMockRecorder
+}
+
+// MockDepositCounterMockRecorder is the mock recorder for MockDepositCounter.
+type MockDepositCounterMockRecorder struct {
+	mock *MockDepositCounter
+}
+
+// NewMockDepositCounter creates a new mock instance.
+func NewMockDepositCounter(ctrl *gomock.Controller) *MockDepositCounter {
+	mock := &MockDepositCounter{ctrl: ctrl}
+	mock.recorder = &MockDepositCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDepositCounter) EXPECT() *MockDepositCounterMockRecorder {
+	return m.recorder
+}
+
+// GetDepositCountAt mocks base method.
+func (m *MockDepositCounter) GetDepositCountAt(destDomainID uint8, blockNumber *big.Int) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepositCountAt", destDomainID, blockNumber)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepositCountAt indicates an expected call of GetDepositCountAt.
+func (mr *MockDepositCounterMockRecorder) GetDepositCountAt(destDomainID, blockNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositCountAt", reflect.TypeOf((*MockDepositCounter)(nil).GetDepositCountAt), destDomainID, blockNumber)
+}
+
+// MockHeaderClient is a mock of HeaderClient interface.
+type MockHeaderClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockHeaderClientMockRecorder
+}
+
+// MockHeaderClientMockRecorder is the mock recorder for MockHeaderClient.
+type MockHeaderClientMockRecorder struct {
+	mock *MockHeaderClient
+}
+
+// NewMockHeaderClient creates a new mock instance.
+func NewMockHeaderClient(ctrl *gomock.Controller) *MockHeaderClient {
+	mock := &MockHeaderClient{ctrl: ctrl}
+	mock.recorder = &MockHeaderClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHeaderClient) EXPECT() *MockHeaderClientMockRecorder {
+	return m.recorder
+}
+
+// HeaderByNumber mocks base method.
+func (m *MockHeaderClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeaderByNumber", ctx, number)
+	ret0, _ := ret[0].(*types.Header)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeaderByNumber indicates an expected call of HeaderByNumber.
+func (mr *MockHeaderClientMockRecorder) HeaderByNumber(ctx, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeaderByNumber", reflect.TypeOf((*MockHeaderClient)(nil).HeaderByNumber), ctx, number)
+}
+
+// MockPauser is a mock of Pauser interface.
+type MockPauser struct {
+	ctrl     *gomock.Controller
+	recorder *MockPauserMockRecorder
+}
+
+// MockPauserMockRecorder is the mock recorder for MockPauser.
+type MockPauserMockRecorder struct {
+	mock *MockPauser
+}
+
+// NewMockPauser creates a new mock instance.
+func NewMockPauser(ctrl *gomock.Controller) *MockPauser {
+	mock := &MockPauser{ctrl: ctrl}
+	mock.recorder = &MockPauserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPauser) EXPECT() *MockPauserMockRecorder {
+	return m.recorder
+}
+
+// Pause mocks base method.
+func (m *MockPauser) Pause(opts transactor.TransactOptions) (*common.Hash, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", opts)
+	ret0, _ := ret[0].(*common.Hash)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockPauserMockRecorder) Pause(opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockPauser)(nil).Pause), opts)
+}
+
+// MockAlarmReporter is a mock of AlarmReporter interface.
+type MockAlarmReporter struct {
+	ctrl     *gomock.Controller
+	recorder *MockAlarmReporterMockRecorder
+}
+
+// MockAlarmReporterMockRecorder is the mock recorder for MockAlarmReporter.
+type MockAlarmReporterMockRecorder struct {
+	mock *MockAlarmReporter
+}
+
+// NewMockAlarmReporter creates a new mock instance.
+func NewMockAlarmReporter(ctrl *gomock.Controller) *MockAlarmReporter {
+	mock := &MockAlarmReporter{ctrl: ctrl}
+	mock.recorder = &MockAlarmReporterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAlarmReporter) EXPECT() *MockAlarmReporterMockRecorder {
+	return m.recorder
+}
+
+// Report mocks base method.
+func (m *MockAlarmReporter) Report(alarm *watcher.Alarm) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Report", alarm)
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockAlarmReporterMockRecorder) Report(alarm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockAlarmReporter)(nil).Report), alarm)
+}
@@ -0,0 +1,482 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/rs/zerolog/log"
+)
+
+type MessageHandler interface {
+	HandleMessage(m *message.Message) (*proposal.Proposal, error)
+}
+
+const (
+	// expectedNonceWindow is the number of the latest deposits of each source data hashes are kept for
+	expectedNonceWindow = 10000
+)
+
+var DefaultPendingVoteTimeout = 30 * time.Minute
+
+type VoteListener interface {
+	FetchProposalVotes(ctx context.Context, address common.Address, startBlock *big.Int, endBlock *big.Int) ([]*events.ProposalVote, error)
+	FetchUnpaused(ctx context.Context, address common.Address, startBlock *big.Int, endBlock *big.Int) (bool, error)
+}
+
+type TransactionClient interface {
+	TransactionByHash(ctx context.Context, hash common.Hash) (tx *ethTypes.Transaction, isPending bool, err error)
+}
+
+// DepositCounter returns number of deposits made to the destination domain at the block.
+// Nil block number is the latest block.
+type DepositCounter interface {
+	GetDepositCountAt(destDomainID uint8, blockNumber *big.Int) (uint64, error)
+}
+
+type HeaderClient interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*ethTypes.Header, error)
+}
+
+type Pauser interface {
+	Pause(opts transactor.TransactOptions) (*common.Hash, error)
+}
+
+// Alarm describes a vote cast for a proposal that does not match any deposit on the source chain.
+// Only confirmed alarms pause the bridge.
+type Alarm struct {
+	DomainID uint8
+	Vote     *events.ProposalVote
+	// Voter is the sender of the vote transaction. It is zero address if
+	// it could not be resolved.
+	Voter common.Address
+	// ExpectedDataHash is the data hash of the matching deposit.
+	// It is zero hash if deposit with the vote nonce does not exist.
+	ExpectedDataHash common.Hash
+	// Unrouted is true if deposit with the vote nonce was not routed to the watcher, or
+	// confirmed missing on source chain, before the vote timed out
+	Unrouted bool
+	// Confirmed is true if the vote data hash does not match the deposit, or the deposit is
+	// missing at a confirmed source block mined after the vote
+	Confirmed bool
+}
+
+func (a *Alarm) Error() string {
+	if a.Unrouted {
+		return fmt.Sprintf(
+			"relayer %s voted on domain %d for deposit %d from domain %d that was not relayed or confirmed missing in time",
+			a.Voter, a.DomainID, a.Vote.DepositNonce, a.Vote.OriginDomainID,
+		)
+	}
+	if a.ExpectedDataHash == (common.Hash{}) {
+		return fmt.Sprintf(
+			"relayer %s voted on domain %d for deposit %d from domain %d that does not exist",
+			a.Voter, a.DomainID, a.Vote.DepositNonce, a.Vote.OriginDomainID,
+		)
+	}
+	return fmt.Sprintf(
+		"relayer %s voted on domain %d for deposit %d from domain %d with data hash %s instead of %s",
+		a.Voter, a.DomainID, a.Vote.DepositNonce, a.Vote.OriginDomainID, common.Hash(a.Vote.DataHash), a.ExpectedDataHash,
+	)
+}
+
+type AlarmReporter interface {
+	Report(alarm *Alarm)
+}
+
+// LogAlarmReporter is AlarmReporter that logs alarms
+type LogAlarmReporter struct{}
+
+func (r *LogAlarmReporter) Report(alarm *Alarm) {
+	log.Error().
+		Uint8("domainID", alarm.DomainID).
+		Uint8("src", alarm.Vote.OriginDomainID).
+		Uint64("nonce", alarm.Vote.DepositNonce).
+		Str("voter", alarm.Voter.Hex()).
+		Str("tx", alarm.Vote.TxHash.Hex()).
+		Msgf("Fraudulent vote detected: %s", alarm.Error())
+}
+
+type depositKey struct {
+	source uint8
+	nonce  uint64
+}
+
+type pendingVote struct {
+	vote  *events.ProposalVote
+	since time.Time
+	// found is true if the deposit was found on source chain
+	found bool
+}
+
+type source struct {
+	counter       DepositCounter
+	client        HeaderClient
+	confirmations *big.Int
+}
+
+// Watcher audits votes cast on the bridge of a single destination domain. It is used
+// both as the ProposalExecutor of the domain, recording data hashes of proposals resolved from deposits
+// routed to it, and as an EventHandler of the domain listener, matching ProposalVote events against them.
+type Watcher struct {
+	mh             MessageHandler
+	voteListener   VoteListener
+	client         TransactionClient
+	bridgeAddress  common.Address
+	domainID       uint8
+	sources        map[uint8]*source
+	reporter       AlarmReporter
+	pauser         Pauser
+	pendingTimeout time.Duration
+
+	lock sync.Mutex
+	// expected holds data hashes of proposals resolved from the latest expectedNonceWindow
+	// deposits of each source made to the domain
+	expected     map[depositKey]common.Hash
+	latestNonces map[uint8]uint64
+	nextPrune    int
+	// pending holds votes for deposits that were not yet routed to the watcher
+	pending map[depositKey][]*pendingVote
+	paused  bool
+}
+
+func NewWatcher(
+	mh MessageHandler,
+	voteListener VoteListener,
+	client TransactionClient,
+	bridgeAddress common.Address,
+	domainID uint8,
+	reporter AlarmReporter,
+) *Watcher {
+	return &Watcher{
+		mh:             mh,
+		voteListener:   voteListener,
+		client:         client,
+		bridgeAddress:  bridgeAddress,
+		domainID:       domainID,
+		sources:        make(map[uint8]*source),
+		reporter:       reporter,
+		pendingTimeout: DefaultPendingVoteTimeout,
+		expected:       make(map[depositKey]common.Hash),
+		latestNonces:   make(map[uint8]uint64),
+		nextPrune:      expectedNonceWindow,
+		pending:        make(map[depositKey][]*pendingVote),
+	}
+}
+
+// RegisterSource registers deposit counter of the source domain bridge used to detect votes for
+// deposits that were never made. Deposits are confirmed missing at source blocks with block confirmations.
+func (w *Watcher) RegisterSource(domainID uint8, counter DepositCounter, client HeaderClient, confirmations *big.Int) {
+	w.sources[domainID] = &source{counter: counter, client: client, confirmations: confirmations}
+}
+
+// SetPauser sets pauser used to pause the domain bridge when confirmed fraudulent vote is detected.
+// Pauser should send transactions from a bridge admin account.
+func (w *Watcher) SetPauser(pauser Pauser) {
+	w.pauser = pauser
+}
+
+// SetPendingVoteTimeout sets the time votes wait for the deposit to be routed to the watcher.
+// Alarm is raised for votes whose deposits are not routed in time.
+func (w *Watcher) SetPendingVoteTimeout(timeout time.Duration) {
+	w.pendingTimeout = timeout
+}
+
+// Execute records data hash of the proposal resolved from the message and checks
+// votes that were waiting for the deposit against it
+func (w *Watcher) Execute(m *message.Message) error {
+	prop, err := w.mh.HandleMessage(m)
+	if err != nil {
+		return err
+	}
+
+	key := depositKey{source: prop.Source, nonce: prop.DepositNonce}
+	dataHash := prop.GetDataHash()
+	alarms := make([]*Alarm, 0)
+
+	w.lock.Lock()
+	w.expected[key] = dataHash
+	if prop.DepositNonce > w.latestNonces[prop.Source] {
+		w.latestNonces[prop.Source] = prop.DepositNonce
+	}
+	w.pruneExpected()
+	pendingVotes := w.pending[key]
+	delete(w.pending, key)
+	w.lock.Unlock()
+	log.Debug().Uint8("src", prop.Source).Uint64("nonce", prop.DepositNonce).Msgf("Recorded proposal data hash %s", dataHash)
+
+	for _, pv := range pendingVotes {
+		alarm := w.compare(pv.vote, dataHash)
+		if alarm != nil {
+			alarms = append(alarms, alarm)
+		}
+	}
+	w.raise(alarms)
+	return nil
+}
+
+// HandleEvent audits ProposalVote events in the block range, checks whether deposits of pending votes
+// are confirmed missing and raises alarms for votes that waited for their deposits for too long.
+// It never sends messages to msgChan.
+func (w *Watcher) HandleEvent(startBlock *big.Int, endBlock *big.Int, msgChan chan []*message.Message) error {
+	votes, err := w.voteListener.FetchProposalVotes(context.Background(), w.bridgeAddress, startBlock, endBlock)
+	if err != nil {
+		return fmt.Errorf("unable to fetch proposal vote events because of: %+v", err)
+	}
+	unpaused, err := w.voteListener.FetchUnpaused(context.Background(), w.bridgeAddress, startBlock, endBlock)
+	if err != nil {
+		return fmt.Errorf("unable to fetch unpaused events because of: %+v", err)
+	}
+	if unpaused {
+		w.lock.Lock()
+		w.paused = false
+		w.lock.Unlock()
+		log.Info().Uint8("domainID", w.domainID).Msg("Bridge was unpaused")
+	}
+
+	alarms := w.confirmMissingDeposits()
+	alarms = append(alarms, w.expirePendingVotes()...)
+	for _, v := range votes {
+		alarm := w.audit(v)
+		if alarm != nil {
+			alarms = append(alarms, alarm)
+		}
+	}
+	w.raise(alarms)
+	return nil
+}
+
+// audit returns an alarm if the vote does not match the recorded deposit. Votes for deposits that
+// were not yet recorded are kept pending until the deposit is routed or confirmed missing.
+func (w *Watcher) audit(v *events.ProposalVote) *Alarm {
+	key := depositKey{source: v.OriginDomainID, nonce: v.DepositNonce}
+	w.lock.Lock()
+	expected, ok := w.expected[key]
+	w.lock.Unlock()
+	if ok {
+		return w.compare(v, expected)
+	}
+
+	src, ok := w.sources[v.OriginDomainID]
+	if !ok {
+		log.Warn().Uint8("src", v.OriginDomainID).Uint64("nonce", v.DepositNonce).Msg("Unable to audit proposal vote from unwatched domain")
+		return nil
+	}
+	pv := &pendingVote{vote: v, since: time.Now()}
+	depositCount, err := src.counter.GetDepositCountAt(w.domainID, nil)
+	if err != nil {
+		log.Error().Err(err).Uint8("src", v.OriginDomainID).Msg("Unable to fetch source deposit count, vote is kept pending")
+	} else if depositCount >= v.DepositNonce {
+		pv.found = true
+	} else {
+		log.Warn().Uint8("src", v.OriginDomainID).Uint64("nonce", v.DepositNonce).Msg("Deposit of proposal vote not found on source chain, vote is kept pending")
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	// deposit could have been routed while deposit count was fetched
+	expected, ok = w.expected[key]
+	if ok {
+		return w.compare(v, expected)
+	}
+	w.pending[key] = append(w.pending[key], pv)
+	return nil
+}
+
+// confirmMissingDeposits returns confirmed alarms for pending votes whose deposits are missing at
+// the confirmed source block. Only blocks mined after the vote was seen confirm the deposit is missing,
+// as the deposit had to be made before the vote. Lagging source endpoints can't confirm it.
+func (w *Watcher) confirmMissingDeposits() []*Alarm {
+	w.lock.Lock()
+	sourceIDs := make(map[uint8]bool)
+	for key, votes := range w.pending {
+		for _, pv := range votes {
+			if !pv.found {
+				sourceIDs[key.source] = true
+			}
+		}
+	}
+	w.lock.Unlock()
+
+	alarms := make([]*Alarm, 0)
+	for sourceID := range sourceIDs {
+		depositCount, minedAt, err := w.confirmedDepositCount(w.sources[sourceID])
+		if err != nil {
+			log.Error().Err(err).Uint8("src", sourceID).Msg("Unable to fetch confirmed source deposit count")
+			continue
+		}
+
+		w.lock.Lock()
+		for key, votes := range w.pending {
+			if key.source != sourceID {
+				continue
+			}
+			kept := make([]*pendingVote, 0, len(votes))
+			for _, pv := range votes {
+				switch {
+				case pv.found:
+				case depositCount >= key.nonce:
+					pv.found = true
+				case minedAt.After(pv.since):
+					alarm := w.newAlarm(pv.vote, common.Hash{})
+					alarm.Confirmed = true
+					alarms = append(alarms, alarm)
+					continue
+				}
+				kept = append(kept, pv)
+			}
+			if len(kept) == 0 {
+				delete(w.pending, key)
+			} else {
+				w.pending[key] = kept
+			}
+		}
+		w.lock.Unlock()
+	}
+	return alarms
+}
+
+// confirmedDepositCount returns deposit count at the source block with block confirmations and the time it was mined at
+func (w *Watcher) confirmedDepositCount(src *source) (uint64, time.Time, error) {
+	head, err := src.client.HeaderByNumber(context.Background(), nil)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	confirmed := head
+	if src.confirmations != nil && src.confirmations.Sign() > 0 {
+		number := new(big.Int).Sub(head.Number, src.confirmations)
+		if number.Sign() < 0 {
+			number = big.NewInt(0)
+		}
+		confirmed, err = src.client.HeaderByNumber(context.Background(), number)
+		if err != nil {
+			return 0, time.Time{}, err
+		}
+	}
+	depositCount, err := src.counter.GetDepositCountAt(w.domainID, confirmed.Number)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return depositCount, time.Unix(int64(confirmed.Time), 0), nil
+}
+
+func (w *Watcher) compare(v *events.ProposalVote, expected common.Hash) *Alarm {
+	if expected != common.Hash(v.DataHash) {
+		alarm := w.newAlarm(v, expected)
+		alarm.Confirmed = true
+		return alarm
+	}
+	log.Debug().Uint8("src", v.OriginDomainID).Uint64("nonce", v.DepositNonce).Msg("Proposal vote matches deposit")
+	return nil
+}
+
+// expirePendingVotes returns alarms for pending votes whose deposits were not routed in time
+func (w *Watcher) expirePendingVotes() []*Alarm {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	alarms := make([]*Alarm, 0)
+	for key, votes := range w.pending {
+		kept := make([]*pendingVote, 0, len(votes))
+		for _, pv := range votes {
+			if time.Since(pv.since) < w.pendingTimeout {
+				kept = append(kept, pv)
+				continue
+			}
+			alarm := w.newAlarm(pv.vote, common.Hash{})
+			alarm.Unrouted = true
+			alarms = append(alarms, alarm)
+		}
+		if len(kept) == 0 {
+			delete(w.pending, key)
+		} else {
+			w.pending[key] = kept
+		}
+	}
+	return alarms
+}
+
+// pruneExpected removes data hashes of deposits outside of the nonce window. Pruning runs once the
+// number of recorded deposits grows by the window size, so it is amortized over recorded deposits.
+func (w *Watcher) pruneExpected() {
+	if len(w.expected) < w.nextPrune {
+		return
+	}
+	for key := range w.expected {
+		if key.nonce+expectedNonceWindow <= w.latestNonces[key.source] {
+			delete(w.expected, key)
+		}
+	}
+	w.nextPrune = len(w.expected) + expectedNonceWindow
+}
+
+func (w *Watcher) newAlarm(v *events.ProposalVote, expected common.Hash) *Alarm {
+	return &Alarm{
+		DomainID:         w.domainID,
+		Vote:             v,
+		ExpectedDataHash: expected,
+	}
+}
+
+// raise reports alarms and pauses the bridge if any confirmed alarms were raised
+func (w *Watcher) raise(alarms []*Alarm) {
+	confirmed := false
+	for _, alarm := range alarms {
+		alarm.Voter = w.voter(alarm.Vote)
+		w.reporter.Report(alarm)
+		confirmed = confirmed || alarm.Confirmed
+	}
+	if confirmed {
+		w.pause()
+	}
+}
+
+// pause pauses the bridge unless it is already paused
+func (w *Watcher) pause() {
+	if w.pauser == nil {
+		return
+	}
+	w.lock.Lock()
+	if w.paused {
+		w.lock.Unlock()
+		return
+	}
+	w.paused = true
+	w.lock.Unlock()
+
+	hash, err := w.pauser.Pause(transactor.TransactOptions{})
+	if err != nil {
+		log.Error().Err(err).Uint8("domainID", w.domainID).Msg("Failed pausing bridge")
+		w.lock.Lock()
+		w.paused = false
+		w.lock.Unlock()
+		return
+	}
+	log.Warn().Uint8("domainID", w.domainID).Msgf("Paused bridge with transaction %s", hash)
+}
+
+// voter returns sender of the vote transaction
+func (w *Watcher) voter(v *events.ProposalVote) common.Address {
+	tx, _, err := w.client.TransactionByHash(context.Background(), v.TxHash)
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to fetch vote transaction %s", v.TxHash)
+		return common.Address{}
+	}
+	sender, err := ethTypes.Sender(ethTypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to recover sender of vote transaction %s", v.TxHash)
+		return common.Address{}
+	}
+	return sender
+}
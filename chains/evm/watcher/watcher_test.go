@@ -0,0 +1,307 @@
+package watcher_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor/proposal"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/watcher"
+	mock_watcher "github.com/ChainSafe/chainbridge-core/chains/evm/watcher/mock"
+	"github.com/ChainSafe/chainbridge-core/relayer/message"
+	"github.com/ethereum/go-ethereum/common"
+	ethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	bridgeAddress = common.HexToAddress("0x62877dDCd49aD22f5eDfc6ac108e9a4b5D2bD88B")
+)
+
+type WatcherTestSuite struct {
+	suite.Suite
+	watcher            *watcher.Watcher
+	mockMessageHandler *mock_watcher.MockMessageHandler
+	mockVoteListener   *mock_watcher.MockVoteListener
+	mockClient         *mock_watcher.MockTransactionClient
+	mockDepositCounter *mock_watcher.MockDepositCounter
+	mockHeaderClient   *mock_watcher.MockHeaderClient
+	mockReporter       *mock_watcher.MockAlarmReporter
+	mockPauser         *mock_watcher.MockPauser
+	proposal           *proposal.Proposal
+	voteTx             *ethTypes.Transaction
+	voter              common.Address
+}
+
+func TestRunWatcherTestSuite(t *testing.T) {
+	suite.Run(t, new(WatcherTestSuite))
+}
+
+func (s *WatcherTestSuite) SetupSuite()    {}
+func (s *WatcherTestSuite) TearDownSuite() {}
+func (s *WatcherTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.mockMessageHandler = mock_watcher.NewMockMessageHandler(gomockController)
+	s.mockVoteListener = mock_watcher.NewMockVoteListener(gomockController)
+	s.mockClient = mock_watcher.NewMockTransactionClient(gomockController)
+	s.mockDepositCounter = mock_watcher.NewMockDepositCounter(gomockController)
+	s.mockHeaderClient = mock_watcher.NewMockHeaderClient(gomockController)
+	s.mockReporter = mock_watcher.NewMockAlarmReporter(gomockController)
+	s.mockPauser = mock_watcher.NewMockPauser(gomockController)
+	s.watcher = watcher.NewWatcher(s.mockMessageHandler, s.mockVoteListener, s.mockClient, bridgeAddress, 2, s.mockReporter)
+	s.watcher.RegisterSource(1, s.mockDepositCounter, s.mockHeaderClient, big.NewInt(5))
+
+	s.proposal = proposal.NewProposal(1, 2, 3, [32]byte{1}, []byte{1, 2, 3}, common.HexToAddress("0x1"), bridgeAddress, message.Metadata{})
+
+	key, _ := crypto.GenerateKey()
+	s.voter = crypto.PubkeyToAddress(key.PublicKey)
+	s.voteTx, _ = ethTypes.SignNewTx(key, ethTypes.LatestSignerForChainID(big.NewInt(5)), &ethTypes.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(1),
+		Gas:      21000,
+	})
+}
+func (s *WatcherTestSuite) TearDownTest() {}
+
+func (s *WatcherTestSuite) vote(dataHash common.Hash, status uint8) *events.ProposalVote {
+	return &events.ProposalVote{
+		OriginDomainID: 1,
+		DepositNonce:   3,
+		Status:         status,
+		DataHash:       dataHash,
+		TxHash:         s.voteTx.Hash(),
+	}
+}
+
+func (s *WatcherTestSuite) expectConfirmedDepositCount(depositCount uint64, minedAt time.Time) {
+	s.mockHeaderClient.EXPECT().HeaderByNumber(gomock.Any(), nil).Return(&ethTypes.Header{Number: big.NewInt(100)}, nil)
+	s.mockHeaderClient.EXPECT().HeaderByNumber(gomock.Any(), big.NewInt(95)).Return(&ethTypes.Header{
+		Number: big.NewInt(95),
+		Time:   uint64(minedAt.Unix()),
+	}, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCountAt(uint8(2), big.NewInt(95)).Return(depositCount, nil)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_FetchVotesFails() {
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(nil, errors.New("error"))
+
+	err := s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+
+	s.NotNil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_VoteMatchesDeposit() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(s.proposal, nil)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(
+		[]*events.ProposalVote{s.vote(s.proposal.GetDataHash(), message.ProposalStatusActive)}, nil,
+	)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(false, nil)
+
+	err := s.watcher.Execute(&message.Message{})
+	s.Nil(err)
+	err = s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+	s.Nil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_VoteDataHashMismatch() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(s.proposal, nil)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(
+		[]*events.ProposalVote{s.vote(common.HexToHash("0x5"), message.ProposalStatusActive)}, nil,
+	)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(false, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.voteTx.Hash()).Return(s.voteTx, false, nil)
+	s.mockReporter.EXPECT().Report(gomock.Any()).Do(func(a *watcher.Alarm) {
+		s.Equal(s.voter, a.Voter)
+		s.Equal(s.proposal.GetDataHash(), a.ExpectedDataHash)
+		s.Equal(uint8(2), a.DomainID)
+	})
+
+	err := s.watcher.Execute(&message.Message{})
+	s.Nil(err)
+	err = s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+	s.Nil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_DepositConfirmedMissing() {
+	s.watcher.SetPauser(s.mockPauser)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(
+		[]*events.ProposalVote{s.vote(s.proposal.GetDataHash(), message.ProposalStatusActive)}, nil,
+	)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(6), big.NewInt(10)).Return([]*events.ProposalVote{}, nil)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, gomock.Any(), gomock.Any()).Times(2).Return(false, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCountAt(uint8(2), nil).Return(uint64(2), nil)
+
+	err := s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+	s.Nil(err)
+
+	s.expectConfirmedDepositCount(2, time.Now().Add(time.Hour))
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.voteTx.Hash()).Return(nil, false, errors.New("error"))
+	s.mockReporter.EXPECT().Report(gomock.Any()).Do(func(a *watcher.Alarm) {
+		s.True(a.Confirmed)
+		s.Equal(common.Address{}, a.Voter)
+		s.Equal(common.Hash{}, a.ExpectedDataHash)
+	})
+	s.mockPauser.EXPECT().Pause(gomock.Any()).Return(&common.Hash{}, nil)
+
+	err = s.watcher.HandleEvent(big.NewInt(6), big.NewInt(10), nil)
+	s.Nil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_DepositMissingBeforeVoteNotConfirmed() {
+	s.watcher.SetPauser(s.mockPauser)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(
+		[]*events.ProposalVote{s.vote(s.proposal.GetDataHash(), message.ProposalStatusActive)}, nil,
+	)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(6), big.NewInt(10)).Return([]*events.ProposalVote{}, nil)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, gomock.Any(), gomock.Any()).Times(2).Return(false, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCountAt(uint8(2), nil).Return(uint64(2), nil)
+	s.expectConfirmedDepositCount(2, time.Now().Add(-time.Hour))
+
+	err := s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+	s.Nil(err)
+	err = s.watcher.HandleEvent(big.NewInt(6), big.NewInt(10), nil)
+	s.Nil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_LaggingSourceAlarmsWithoutPause() {
+	s.watcher.SetPauser(s.mockPauser)
+	s.watcher.SetPendingVoteTimeout(0)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(
+		[]*events.ProposalVote{s.vote(s.proposal.GetDataHash(), message.ProposalStatusActive)}, nil,
+	)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(6), big.NewInt(10)).Return([]*events.ProposalVote{}, nil)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, gomock.Any(), gomock.Any()).Times(2).Return(false, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCountAt(uint8(2), nil).Return(uint64(2), nil)
+	s.mockHeaderClient.EXPECT().HeaderByNumber(gomock.Any(), nil).Return(nil, errors.New("error"))
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.voteTx.Hash()).Return(s.voteTx, false, nil)
+	s.mockReporter.EXPECT().Report(gomock.Any()).Do(func(a *watcher.Alarm) {
+		s.True(a.Unrouted)
+		s.False(a.Confirmed)
+	})
+
+	err := s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+	s.Nil(err)
+	err = s.watcher.HandleEvent(big.NewInt(6), big.NewInt(10), nil)
+	s.Nil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_PendingVoteMismatchReportedOnDeposit() {
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(
+		[]*events.ProposalVote{s.vote(common.HexToHash("0x5"), message.ProposalStatusActive)}, nil,
+	)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(false, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCountAt(uint8(2), nil).Return(uint64(3), nil)
+
+	err := s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+	s.Nil(err)
+
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(s.proposal, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.voteTx.Hash()).Return(s.voteTx, false, nil)
+	s.mockReporter.EXPECT().Report(gomock.Any()).Do(func(a *watcher.Alarm) {
+		s.Equal(s.proposal.GetDataHash(), a.ExpectedDataHash)
+	})
+
+	err = s.watcher.Execute(&message.Message{})
+	s.Nil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_UnwatchedSourceIgnored() {
+	v := s.vote(common.HexToHash("0x5"), message.ProposalStatusActive)
+	v.OriginDomainID = 7
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return([]*events.ProposalVote{v}, nil)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(false, nil)
+
+	err := s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+
+	s.Nil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_PausesBridgeOnce() {
+	s.watcher.SetPauser(s.mockPauser)
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(s.proposal, nil)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(
+		[]*events.ProposalVote{
+			s.vote(common.HexToHash("0x5"), message.ProposalStatusActive),
+			s.vote(common.HexToHash("0x5"), message.ProposalStatusPassed),
+		}, nil,
+	)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(false, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.voteTx.Hash()).Times(2).Return(s.voteTx, false, nil)
+	s.mockReporter.EXPECT().Report(gomock.Any()).Times(2)
+	s.mockPauser.EXPECT().Pause(gomock.Any()).Return(&common.Hash{}, nil)
+
+	err := s.watcher.Execute(&message.Message{})
+	s.Nil(err)
+	err = s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+	s.Nil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_PausesBridgeAgainAfterUnpaused() {
+	s.watcher.SetPauser(s.mockPauser)
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(s.proposal, nil)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, gomock.Any(), gomock.Any()).Times(3).Return(
+		[]*events.ProposalVote{s.vote(common.HexToHash("0x5"), message.ProposalStatusActive)}, nil,
+	)
+	gomock.InOrder(
+		s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, gomock.Any(), gomock.Any()).Return(false, nil),
+		s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, gomock.Any(), gomock.Any()).Return(false, nil),
+		s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, gomock.Any(), gomock.Any()).Return(true, nil),
+	)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.voteTx.Hash()).Times(3).Return(s.voteTx, false, nil)
+	s.mockReporter.EXPECT().Report(gomock.Any()).Times(3)
+	s.mockPauser.EXPECT().Pause(gomock.Any()).Times(2).Return(&common.Hash{}, nil)
+
+	err := s.watcher.Execute(&message.Message{})
+	s.Nil(err)
+	for i := 0; i < 3; i++ {
+		err := s.watcher.HandleEvent(big.NewInt(int64(i)), big.NewInt(int64(i)), nil)
+		s.Nil(err)
+	}
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_ForgedVoteAfterProposalPassed() {
+	s.mockMessageHandler.EXPECT().HandleMessage(gomock.Any()).Return(s.proposal, nil)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(
+		[]*events.ProposalVote{
+			s.vote(s.proposal.GetDataHash(), message.ProposalStatusPassed),
+			s.vote(common.HexToHash("0x5"), message.ProposalStatusPassed),
+		}, nil,
+	)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(false, nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.voteTx.Hash()).Return(s.voteTx, false, nil)
+	s.mockReporter.EXPECT().Report(gomock.Any()).Do(func(a *watcher.Alarm) {
+		s.Equal(s.proposal.GetDataHash(), a.ExpectedDataHash)
+		s.Equal(common.HexToHash("0x5"), common.Hash(a.Vote.DataHash))
+	})
+
+	err := s.watcher.Execute(&message.Message{})
+	s.Nil(err)
+	err = s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+	s.Nil(err)
+}
+
+func (s *WatcherTestSuite) TestHandleEvent_PendingVoteExpires() {
+	s.watcher.SetPauser(s.mockPauser)
+	s.watcher.SetPendingVoteTimeout(0)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(0), big.NewInt(5)).Return(
+		[]*events.ProposalVote{s.vote(s.proposal.GetDataHash(), message.ProposalStatusActive)}, nil,
+	)
+	s.mockVoteListener.EXPECT().FetchProposalVotes(gomock.Any(), bridgeAddress, big.NewInt(6), big.NewInt(10)).Return([]*events.ProposalVote{}, nil)
+	s.mockVoteListener.EXPECT().FetchUnpaused(gomock.Any(), bridgeAddress, gomock.Any(), gomock.Any()).Times(2).Return(false, nil)
+	s.mockDepositCounter.EXPECT().GetDepositCountAt(uint8(2), nil).Return(uint64(3), nil)
+	s.mockClient.EXPECT().TransactionByHash(gomock.Any(), s.voteTx.Hash()).Return(s.voteTx, false, nil)
+	s.mockReporter.EXPECT().Report(gomock.Any()).Do(func(a *watcher.Alarm) {
+		s.True(a.Unrouted)
+		s.False(a.Confirmed)
+		s.Equal(s.voter, a.Voter)
+	})
+
+	err := s.watcher.HandleEvent(big.NewInt(0), big.NewInt(5), nil)
+	s.Nil(err)
+	err = s.watcher.HandleEvent(big.NewInt(6), big.NewInt(10), nil)
+	s.Nil(err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ChainSafe/chainbridge-core/flags"
+	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/viper"
 )
 
@@ -17,6 +18,15 @@ type GeneralChainConfig struct {
 	LatestBlock    bool   `mapstructure:"latest"`
 	Key            string
 	Insecure       bool
+	// Endpoints are set when endpoint is configured as an array of URLs or of endpoints
+	// with weights, Endpoint is then the first of them
+	Endpoints []EndpointConfig `mapstructure:"-"`
+}
+
+// EndpointConfig is one of multiple endpoints of the chain
+type EndpointConfig struct {
+	URL    string `mapstructure:"url"`
+	Weight int    `mapstructure:"weight"`
 }
 
 func (c *GeneralChainConfig) Validate() error {
@@ -30,9 +40,48 @@ func (c *GeneralChainConfig) Validate() error {
 	if c.Name == "" {
 		return fmt.Errorf("required field chain.Name empty for chain %v", *c.Id)
 	}
+	for _, e := range c.Endpoints {
+		if e.URL == "" {
+			return fmt.Errorf("required field chain.Endpoint url empty for chain %v", *c.Id)
+		}
+	}
 	return nil
 }
 
+// splitEndpoints returns copy of raw chain config with endpoint array replaced by the
+// first endpoint URL, together with all endpoints of the array
+func splitEndpoints(chainConfig map[string]interface{}) (map[string]interface{}, []EndpointConfig, error) {
+	rawEndpoints, ok := chainConfig["endpoint"].([]interface{})
+	if !ok {
+		return chainConfig, nil, nil
+	}
+
+	endpoints := make([]EndpointConfig, len(rawEndpoints))
+	for i, e := range rawEndpoints {
+		if url, ok := e.(string); ok {
+			endpoints[i].URL = url
+		} else {
+			err := mapstructure.Decode(e, &endpoints[i])
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		if endpoints[i].Weight == 0 {
+			endpoints[i].Weight = 1
+		}
+	}
+
+	config := make(map[string]interface{}, len(chainConfig))
+	for k, v := range chainConfig {
+		config[k] = v
+	}
+	delete(config, "endpoint")
+	if len(endpoints) > 0 {
+		config["endpoint"] = endpoints[0].URL
+	}
+	return config, endpoints, nil
+}
+
 func (c *GeneralChainConfig) ParseFlags() {
 	blockstore := viper.GetString(flags.BlockstoreFlagName)
 	if blockstore != "" {
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/creasty/defaults"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/mitchellh/mapstructure"
 )
@@ -19,25 +20,106 @@ type EVMConfig struct {
 	MaxGasPrice        *big.Int
 	GasMultiplier      *big.Float
 	GasLimit           *big.Int
+	// GasLimitMultiplier is a safety multiplier applied to gas estimates
+	GasLimitMultiplier float64
+	// GasLimitCeiling is the highest gas limit of estimated transactions
+	GasLimitCeiling    *big.Int
 	StartBlock         *big.Int
 	BlockConfirmations *big.Int
 	BlockInterval      *big.Int
 	BlockRetryInterval time.Duration
+	// VerificationEndpoint is a secondary endpoint used to independently verify deposits made on this chain
+	VerificationEndpoint string
+	// ReceiptProofVerification enables verification of deposit receipts inclusion against block receipts root
+	ReceiptProofVerification bool
+	// ResubmitTimeout is the time after which pending transaction is replaced with bumped fees
+	ResubmitTimeout time.Duration
+	// AdminKey is a private key of the bridge admin used by watcher to pause the bridge on fraudulent votes
+	AdminKey string
+	// AsyncVoting makes relayer send votes without waiting for them to be mined
+	AsyncVoting bool
+	// ShadowRelayers are addresses of production relayers whose votes the shadow voter compares proposals with
+	ShadowRelayers []string
+	// L1FeeOracle is the type of rollup L1 fee oracle used to estimate L1 data fees, "optimism" or "arbitrum".
+	// Arbitrum gas estimates include L1 data cost, so no L1 fee is added on top of them.
+	L1FeeOracle string
+	GasPricer   GasPricerConfig
+	// SenderKeys are private keys used together with the relayer key to send transactions in parallel
+	SenderKeys []string
+	// HourlySpendingBudget and DailySpendingBudget limit wei spent on transaction fees. If nil - not applied
+	HourlySpendingBudget *big.Int
+	DailySpendingBudget  *big.Int
+	// QuorumEndpoints are endpoints contract calls and event logs are read from when quorum reads are enabled
+	QuorumEndpoints []string
+	// Quorum is the number of quorum endpoints that have to return the same result
+	Quorum int
+	// CallBatchWindow is the window contract calls are coalesced into JSON-RPC batches within, configured
+	// in milliseconds. If 0 - calls are not batched
+	CallBatchWindow time.Duration
+	// RequestsPerSecond limits the rate of requests sent to each HTTP endpoint. If 0 - not limited
+	RequestsPerSecond float64
+	// MaxRetries is the number of times throttled or failed RPC request is retried
+	MaxRetries int
+	// BridgeCacheTTL is the time bridge reads changed only by admin transactions, like relayer threshold
+	// or resource handlers, are cached for. Resource handlers are cached for at most a minute.
+	BridgeCacheTTL time.Duration
+	// Faults are injected into requests sent to the endpoint to debug recovery of the relayer
+	// from endpoint failures. Never set on production relayers.
+	Faults []FaultConfig
+}
+
+// GasPricerConfig selects gas pricer of the chain
+type GasPricerConfig struct {
+	// Type is the registered gas pricer type, e.g. static, london, fee-history or oracle
+	Type    string                 `mapstructure:"type" default:"static"`
+	Options map[string]interface{} `mapstructure:"options"`
+}
+
+// FaultConfig injects fault into RPC requests
+type FaultConfig struct {
+	// Type is the fault type, e.g. timeout, server-error, dropped-transaction, nonce, stale-head or reorged-logs
+	Type string `mapstructure:"type"`
+	// Probability is the chance of injecting the fault into a request
+	Probability float64 `mapstructure:"probability"`
+	// Every injects the fault into every n-th request
+	Every int `mapstructure:"every"`
+	// Methods limits the fault to requests of the JSON-RPC methods
+	Methods []string `mapstructure:"methods"`
 }
 
 type RawEVMConfig struct {
-	GeneralChainConfig `mapstructure:",squash"`
-	Bridge             string  `mapstructure:"bridge"`
-	Erc20Handler       string  `mapstructure:"erc20Handler"`
-	Erc721Handler      string  `mapstructure:"erc721Handler"`
-	GenericHandler     string  `mapstructure:"genericHandler"`
-	MaxGasPrice        int64   `mapstructure:"maxGasPrice" default:"20000000000"`
-	GasMultiplier      float64 `mapstructure:"gasMultiplier" default:"1"`
-	GasLimit           int64   `mapstructure:"gasLimit" default:"2000000"`
-	StartBlock         int64   `mapstructure:"startBlock"`
-	BlockConfirmations int64   `mapstructure:"blockConfirmations" default:"10"`
-	BlockInterval      int64   `mapstructure:"blockInterval" default:"5"`
-	BlockRetryInterval uint64  `mapstructure:"blockRetryInterval" default:"5"`
+	GeneralChainConfig       `mapstructure:",squash"`
+	Bridge                   string          `mapstructure:"bridge"`
+	Erc20Handler             string          `mapstructure:"erc20Handler"`
+	Erc721Handler            string          `mapstructure:"erc721Handler"`
+	GenericHandler           string          `mapstructure:"genericHandler"`
+	MaxGasPrice              int64           `mapstructure:"maxGasPrice" default:"20000000000"`
+	GasMultiplier            float64         `mapstructure:"gasMultiplier" default:"1"`
+	GasLimit                 int64           `mapstructure:"gasLimit" default:"2000000"`
+	GasLimitMultiplier       float64         `mapstructure:"gasLimitMultiplier" default:"1.2"`
+	GasLimitCeiling          int64           `mapstructure:"gasLimitCeiling" default:"8000000"`
+	StartBlock               int64           `mapstructure:"startBlock"`
+	BlockConfirmations       int64           `mapstructure:"blockConfirmations" default:"10"`
+	BlockInterval            int64           `mapstructure:"blockInterval" default:"5"`
+	BlockRetryInterval       uint64          `mapstructure:"blockRetryInterval" default:"5"`
+	VerificationEndpoint     string          `mapstructure:"verificationEndpoint"`
+	ReceiptProofVerification bool            `mapstructure:"receiptProofVerification"`
+	AdminKey                 string          `mapstructure:"adminKey"`
+	ResubmitTimeout          uint64          `mapstructure:"resubmitTimeout" default:"180"`
+	AsyncVoting              bool            `mapstructure:"asyncVoting"`
+	ShadowRelayers           []string        `mapstructure:"shadowRelayers"`
+	SenderKeys               []string        `mapstructure:"senderKeys"`
+	L1FeeOracle              string          `mapstructure:"l1FeeOracle"`
+	GasPricer                GasPricerConfig `mapstructure:"gasPricer"`
+	HourlySpendingBudget     string          `mapstructure:"hourlySpendingBudget"`
+	DailySpendingBudget      string          `mapstructure:"dailySpendingBudget"`
+	QuorumEndpoints          []string        `mapstructure:"quorumEndpoints"`
+	Quorum                   int             `mapstructure:"quorum"`
+	CallBatchWindow          uint64          `mapstructure:"callBatchWindow"`
+	RequestsPerSecond        float64         `mapstructure:"requestsPerSecond"`
+	MaxRetries               int             `mapstructure:"maxRetries" default:"5"`
+	BridgeCacheTTL           uint64          `mapstructure:"bridgeCacheTTL" default:"600"`
+	Faults                   []FaultConfig   `mapstructure:"faults"`
 }
 
 func (c *RawEVMConfig) Validate() error {
@@ -50,17 +132,72 @@ func (c *RawEVMConfig) Validate() error {
 	if c.BlockConfirmations != 0 && c.BlockConfirmations < 1 {
 		return fmt.Errorf("blockConfirmations has to be >=1")
 	}
+	if c.L1FeeOracle != "" && c.L1FeeOracle != "optimism" && c.L1FeeOracle != "arbitrum" {
+		return fmt.Errorf("unsupported l1FeeOracle %s", c.L1FeeOracle)
+	}
+	if _, err := parseWei(c.HourlySpendingBudget); err != nil {
+		return fmt.Errorf("invalid hourlySpendingBudget: %w", err)
+	}
+	if _, err := parseWei(c.DailySpendingBudget); err != nil {
+		return fmt.Errorf("invalid dailySpendingBudget: %w", err)
+	}
+	for _, relayer := range c.ShadowRelayers {
+		if !common.IsHexAddress(relayer) {
+			return fmt.Errorf("invalid shadowRelayers address %s", relayer)
+		}
+	}
+	if c.ReceiptProofVerification && c.VerificationEndpoint == "" {
+		return fmt.Errorf("receiptProofVerification requires verificationEndpoint")
+	}
+	if c.RequestsPerSecond < 0 {
+		return fmt.Errorf("requestsPerSecond has to be >=0")
+	}
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("maxRetries has to be >=0")
+	}
+	if len(c.QuorumEndpoints) > 0 && (c.Quorum < 0 || c.Quorum > len(c.QuorumEndpoints)) {
+		return fmt.Errorf("quorum has to be between 1 and the number of quorumEndpoints")
+	}
+	if len(c.Faults) > 0 && len(c.GeneralChainConfig.Endpoints) > 0 {
+		return fmt.Errorf("faults can be injected only with a single endpoint")
+	}
+	for _, f := range c.Faults {
+		if f.Probability < 0 || f.Probability > 1 {
+			return fmt.Errorf("fault probability has to be between 0 and 1")
+		}
+		if f.Every < 0 {
+			return fmt.Errorf("fault every has to be >=0")
+		}
+	}
 	return nil
 }
 
+// parseWei parses decimal wei amount, returning nil for empty string
+func parseWei(amount string) (*big.Int, error) {
+	if amount == "" {
+		return nil, nil
+	}
+	wei, ok := new(big.Int).SetString(amount, 10)
+	if !ok || wei.Sign() < 0 {
+		return nil, fmt.Errorf("%s is not a wei amount", amount)
+	}
+	return wei, nil
+}
+
 // NewEVMConfig decodes and validates an instance of an EVMConfig from
 // raw chain config
 func NewEVMConfig(chainConfig map[string]interface{}) (*EVMConfig, error) {
+	chainConfig, endpoints, err := splitEndpoints(chainConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	var c RawEVMConfig
-	err := mapstructure.Decode(chainConfig, &c)
+	err = mapstructure.Decode(chainConfig, &c)
 	if err != nil {
 		return nil, err
 	}
+	c.GeneralChainConfig.Endpoints = endpoints
 
 	err = defaults.Set(&c)
 	if err != nil {
@@ -74,19 +211,43 @@ func NewEVMConfig(chainConfig map[string]interface{}) (*EVMConfig, error) {
 
 	c.GeneralChainConfig.ParseFlags()
 	config := &EVMConfig{
-		GeneralChainConfig: c.GeneralChainConfig,
-		Erc20Handler:       c.Erc20Handler,
-		Erc721Handler:      c.Erc721Handler,
-		GenericHandler:     c.GenericHandler,
-		Bridge:             c.Bridge,
-		BlockRetryInterval: time.Duration(c.BlockRetryInterval) * time.Second,
-		GasLimit:           big.NewInt(c.GasLimit),
-		MaxGasPrice:        big.NewInt(c.MaxGasPrice),
-		GasMultiplier:      big.NewFloat(c.GasMultiplier),
-		StartBlock:         big.NewInt(c.StartBlock),
-		BlockConfirmations: big.NewInt(c.BlockConfirmations),
-		BlockInterval:      big.NewInt(c.BlockInterval),
+		GeneralChainConfig:       c.GeneralChainConfig,
+		Erc20Handler:             c.Erc20Handler,
+		Erc721Handler:            c.Erc721Handler,
+		GenericHandler:           c.GenericHandler,
+		Bridge:                   c.Bridge,
+		BlockRetryInterval:       time.Duration(c.BlockRetryInterval) * time.Second,
+		GasLimit:                 big.NewInt(c.GasLimit),
+		GasLimitMultiplier:       c.GasLimitMultiplier,
+		GasLimitCeiling:          big.NewInt(c.GasLimitCeiling),
+		MaxGasPrice:              big.NewInt(c.MaxGasPrice),
+		GasMultiplier:            big.NewFloat(c.GasMultiplier),
+		StartBlock:               big.NewInt(c.StartBlock),
+		BlockConfirmations:       big.NewInt(c.BlockConfirmations),
+		BlockInterval:            big.NewInt(c.BlockInterval),
+		VerificationEndpoint:     c.VerificationEndpoint,
+		ReceiptProofVerification: c.ReceiptProofVerification,
+		AdminKey:                 c.AdminKey,
+		ResubmitTimeout:          time.Duration(c.ResubmitTimeout) * time.Second,
+		AsyncVoting:              c.AsyncVoting,
+		ShadowRelayers:           c.ShadowRelayers,
+		SenderKeys:               c.SenderKeys,
+		L1FeeOracle:              c.L1FeeOracle,
+		GasPricer:                c.GasPricer,
+		CallBatchWindow:          time.Duration(c.CallBatchWindow) * time.Millisecond,
+		RequestsPerSecond:        c.RequestsPerSecond,
+		MaxRetries:               c.MaxRetries,
+		BridgeCacheTTL:           time.Duration(c.BridgeCacheTTL) * time.Second,
+		Faults:                   c.Faults,
+	}
+	config.QuorumEndpoints = c.QuorumEndpoints
+	config.Quorum = c.Quorum
+	// majority of quorum endpoints by default
+	if len(c.QuorumEndpoints) > 0 && c.Quorum == 0 {
+		config.Quorum = len(c.QuorumEndpoints)/2 + 1
 	}
+	config.HourlySpendingBudget, _ = parseWei(c.HourlySpendingBudget)
+	config.DailySpendingBudget, _ = parseWei(c.DailySpendingBudget)
 
 	return config, nil
 }
@@ -56,6 +56,34 @@ func (s *NewEVMConfigTestSuite) Test_InvalidBlockConfirmation() {
 	s.Equal(err.Error(), "blockConfirmations has to be >=1")
 }
 
+func (s *NewEVMConfigTestSuite) Test_InvalidL1FeeOracle() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":          1,
+		"endpoint":    "ws://domain.com",
+		"name":        "evm1",
+		"from":        "address",
+		"bridge":      "bridgeAddress",
+		"l1FeeOracle": "zksync",
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "unsupported l1FeeOracle zksync")
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidSpendingBudget() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":                  1,
+		"endpoint":            "ws://domain.com",
+		"name":                "evm1",
+		"from":                "address",
+		"bridge":              "bridgeAddress",
+		"dailySpendingBudget": "1 ETH",
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "invalid dailySpendingBudget: 1 ETH is not a wei amount")
+}
+
 func (s *NewEVMConfigTestSuite) Test_ValidConfig() {
 	rawConfig := map[string]interface{}{
 		"id":             1,
@@ -87,13 +115,150 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfig() {
 		Erc721Handler:      "",
 		GenericHandler:     "",
 		GasLimit:           big.NewInt(2000000),
+		GasLimitMultiplier: 1.2,
+		GasLimitCeiling:    big.NewInt(8000000),
 		MaxGasPrice:        big.NewInt(20000000000),
 		GasMultiplier:      big.NewFloat(1),
 		StartBlock:         big.NewInt(0),
 		BlockConfirmations: big.NewInt(10),
 		BlockInterval:      big.NewInt(5),
 		BlockRetryInterval: time.Duration(5) * time.Second,
+		ResubmitTimeout:    time.Duration(180) * time.Second,
+		GasPricer:          chain.GasPricerConfig{Type: "static"},
+		MaxRetries:         5,
+		BridgeCacheTTL:     time.Duration(600) * time.Second,
+	})
+}
+
+func (s *NewEVMConfigTestSuite) Test_ReceiptProofVerificationWithoutEndpoint() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":                       1,
+		"endpoint":                 "ws://domain.com",
+		"name":                     "evm1",
+		"from":                     "address",
+		"bridge":                   "bridgeAddress",
+		"receiptProofVerification": true,
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "receiptProofVerification requires verificationEndpoint")
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidShadowRelayer() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":             1,
+		"endpoint":       "ws://domain.com",
+		"name":           "evm1",
+		"from":           "address",
+		"bridge":         "bridgeAddress",
+		"shadowRelayers": []string{"0xff93B45308FD417dF303D6515aB04D9e89a750Ca", "relayer"},
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "invalid shadowRelayers address relayer")
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidQuorum() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":              1,
+		"endpoint":        "ws://domain.com",
+		"name":            "evm1",
+		"from":            "address",
+		"bridge":          "bridgeAddress",
+		"quorumEndpoints": []string{"ws://a.com", "ws://b.com"},
+		"quorum":          3,
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "quorum has to be between 1 and the number of quorumEndpoints")
+}
+
+func (s *NewEVMConfigTestSuite) Test_DefaultQuorumIsMajority() {
+	actualConfig, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":              1,
+		"endpoint":        "ws://domain.com",
+		"name":            "evm1",
+		"from":            "address",
+		"bridge":          "bridgeAddress",
+		"quorumEndpoints": []string{"ws://a.com", "ws://b.com", "ws://c.com"},
+	})
+
+	s.Nil(err)
+	s.Equal(2, actualConfig.Quorum)
+}
+
+func (s *NewEVMConfigTestSuite) Test_ValidConfigWithFaults() {
+	actualConfig, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "http://domain.com",
+		"name":     "evm1",
+		"from":     "address",
+		"bridge":   "bridgeAddress",
+		"faults": []interface{}{
+			map[string]interface{}{"type": "server-error", "probability": 0.1},
+			map[string]interface{}{"type": "timeout", "every": 10, "methods": []string{"eth_getLogs"}},
+		},
+	})
+
+	s.Nil(err)
+	s.Equal([]chain.FaultConfig{
+		{Type: "server-error", Probability: 0.1},
+		{Type: "timeout", Every: 10, Methods: []string{"eth_getLogs"}},
+	}, actualConfig.Faults)
+}
+
+func (s *NewEVMConfigTestSuite) Test_InvalidFaultProbability() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": "http://domain.com",
+		"name":     "evm1",
+		"from":     "address",
+		"bridge":   "bridgeAddress",
+		"faults": []interface{}{
+			map[string]interface{}{"type": "server-error", "probability": 2},
+		},
+	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "fault probability has to be between 0 and 1")
+}
+
+func (s *NewEVMConfigTestSuite) Test_FaultsWithMultipleEndpoints() {
+	_, err := chain.NewEVMConfig(map[string]interface{}{
+		"id":       1,
+		"endpoint": []interface{}{"http://domain.com", "http://backup.com"},
+		"name":     "evm1",
+		"from":     "address",
+		"bridge":   "bridgeAddress",
+		"faults": []interface{}{
+			map[string]interface{}{"type": "server-error", "probability": 0.1},
+		},
 	})
+
+	s.NotNil(err)
+	s.Equal(err.Error(), "faults can be injected only with a single endpoint")
+}
+
+func (s *NewEVMConfigTestSuite) Test_ValidConfigWithMultipleEndpoints() {
+	rawConfig := map[string]interface{}{
+		"id":   1,
+		"name": "evm1",
+		"endpoint": []interface{}{
+			map[string]interface{}{"url": "ws://domain.com", "weight": 3},
+			"ws://backup.com",
+		},
+		"from":   "address",
+		"bridge": "bridgeAddress",
+	}
+
+	actualConfig, err := chain.NewEVMConfig(rawConfig)
+
+	s.Nil(err)
+	s.Equal("ws://domain.com", actualConfig.GeneralChainConfig.Endpoint)
+	s.Equal([]chain.EndpointConfig{
+		{URL: "ws://domain.com", Weight: 3},
+		{URL: "ws://backup.com", Weight: 1},
+	}, actualConfig.GeneralChainConfig.Endpoints)
 }
 
 func (s *NewEVMConfigTestSuite) Test_ValidConfigWithCustomTxParams() {
@@ -106,10 +271,23 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfigWithCustomTxParams() {
 		"maxGasPrice":        1000,
 		"gasMultiplier":      1000,
 		"gasLimit":           1000,
+		"gasLimitMultiplier": 1.5,
+		"gasLimitCeiling":    5000,
 		"startBlock":         1000,
 		"blockConfirmations": 10,
 		"blockRetryInterval": 10,
 		"blockInterval":      2,
+		"resubmitTimeout":    60,
+		"gasPricer": map[string]interface{}{
+			"type":    "fee-history",
+			"options": map[string]interface{}{"blockCount": 20},
+		},
+		"hourlySpendingBudget": "100000000000000000",
+		"dailySpendingBudget":  "1000000000000000000",
+		"callBatchWindow":      20,
+		"requestsPerSecond":    10,
+		"maxRetries":           2,
+		"bridgeCacheTTL":       60,
 	}
 
 	actualConfig, err := chain.NewEVMConfig(rawConfig)
@@ -128,11 +306,24 @@ func (s *NewEVMConfigTestSuite) Test_ValidConfigWithCustomTxParams() {
 		Erc721Handler:      "",
 		GenericHandler:     "",
 		GasLimit:           big.NewInt(1000),
+		GasLimitMultiplier: 1.5,
+		GasLimitCeiling:    big.NewInt(5000),
 		MaxGasPrice:        big.NewInt(1000),
 		GasMultiplier:      big.NewFloat(1000),
 		StartBlock:         big.NewInt(1000),
 		BlockConfirmations: big.NewInt(10),
 		BlockInterval:      big.NewInt(2),
 		BlockRetryInterval: time.Duration(10) * time.Second,
+		ResubmitTimeout:    time.Duration(60) * time.Second,
+		GasPricer: chain.GasPricerConfig{
+			Type:    "fee-history",
+			Options: map[string]interface{}{"blockCount": 20},
+		},
+		HourlySpendingBudget: big.NewInt(100000000000000000),
+		DailySpendingBudget:  big.NewInt(1000000000000000000),
+		CallBatchWindow:      time.Duration(20) * time.Millisecond,
+		RequestsPerSecond:    10,
+		MaxRetries:           2,
+		BridgeCacheTTL:       time.Duration(60) * time.Second,
 	})
 }
@@ -0,0 +1,373 @@
+package simulated
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/consts"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/erc20"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	evmsimulated "github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/simulated"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/local"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	"github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/opentelemetry"
+	"github.com/ChainSafe/chainbridge-core/relayer"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// BlockTime is the interval blocks are mined in. Proposals expire after 100 blocks,
+	// so it has to leave relayers enough time to vote with latency.
+	BlockTime          = 250 * time.Millisecond
+	BlockRetryInterval = 50 * time.Millisecond
+)
+
+type HarnessOpts struct {
+	// Relayers is the number of relayer instances, each voting with its own key
+	Relayers int
+	// Threshold is the number of votes required to execute a proposal
+	Threshold uint8
+	// DataDir is the directory relayer blockstores are kept in
+	DataDir string
+}
+
+// Domain is an in-process chain with deployed bridge contracts
+type Domain struct {
+	ID     uint8
+	Chain  *evmsimulated.Chain
+	Config local.BridgeConfig
+}
+
+// RelayerNode is a relayer instance of the harness
+type RelayerNode struct {
+	Keypair *secp256k1.Keypair
+
+	latency    int64
+	db         *lvldb.LVLDB
+	blockstore *store.BlockStore
+	cancel     context.CancelFunc
+	conns      []net.Conn
+	started    bool
+}
+
+// Harness runs multiple relayers with different keys against two in-process chains
+// so that threshold voting and races between relayers can be tested without docker.
+// Admin deploys the bridges and makes deposits with Eve key.
+type Harness struct {
+	Domains  map[uint8]*Domain
+	Relayers []*RelayerNode
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	lock   sync.Mutex
+}
+
+// NewHarness creates chains with domain IDs 1 and 2 and deploys bridges on them with
+// opts.Relayers generated relayer keys and opts.Threshold. Relayers are not started.
+func NewHarness(opts HarnessOpts) (*Harness, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &Harness{
+		Domains: make(map[uint8]*Domain),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	relayerAddresses := make([]common.Address, opts.Relayers)
+	for i := 0; i < opts.Relayers; i++ {
+		kp, err := secp256k1.GenerateKeypair()
+		if err != nil {
+			return nil, err
+		}
+		db, err := lvldb.NewLvlDB(filepath.Join(opts.DataDir, fmt.Sprintf("relayer-%d", i)))
+		if err != nil {
+			return nil, err
+		}
+		h.Relayers = append(h.Relayers, &RelayerNode{
+			Keypair:    kp,
+			db:         db,
+			blockstore: store.NewBlockStore(db),
+		})
+		relayerAddresses[i] = kp.CommonAddress()
+	}
+
+	balance := new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)
+	alloc := core.GenesisAlloc{local.EveKp.CommonAddress(): {Balance: balance}}
+	for _, address := range relayerAddresses {
+		alloc[address] = core.GenesisAccount{Balance: balance}
+	}
+	for _, domainID := range []uint8{1, 2} {
+		chain, err := evmsimulated.NewChain(alloc)
+		if err != nil {
+			return nil, err
+		}
+		config, err := local.SetupEVMBridge(
+			chain.Client(local.EveKp), evmtransaction.NewTransaction, domainID,
+			big.NewInt(int64(opts.Threshold)), local.EveKp.CommonAddress(), relayerAddresses,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed deploying bridge on domain %d: %w", domainID, err)
+		}
+		chain.MineEvery(ctx, BlockTime)
+		h.Domains[domainID] = &Domain{ID: domainID, Chain: chain, Config: config}
+	}
+	return h, nil
+}
+
+// Start starts all relayers
+func (h *Harness) Start() error {
+	for i := range h.Relayers {
+		err := h.StartRelayer(i)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StartRelayer starts the relayer. Restarted relayer continues from the last block it stored.
+func (h *Harness) StartRelayer(i int) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	node := h.Relayers[i]
+	if node.cancel != nil {
+		return fmt.Errorf("relayer %d already running", i)
+	}
+
+	chains := make([]relayer.RelayedChain, 0, len(h.Domains))
+	for _, domain := range h.Domains {
+		relayedChain, err := h.relayedChain(node, domain)
+		if err != nil {
+			return err
+		}
+		chains = append(chains, relayedChain)
+	}
+
+	ctx, cancel := context.WithCancel(h.ctx)
+	node.cancel = cancel
+	node.started = true
+	errChn := make(chan error)
+	go func() {
+		for {
+			select {
+			case err := <-errChn:
+				log.Error().Err(err).Msgf("Relayer %d failed", i)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go relayer.NewRelayer(chains, &opentelemetry.ConsoleTelemetry{}).Start(ctx, errChn)
+	return nil
+}
+
+// CrashRelayer stops the relayer abruptly, failing all its in-flight requests
+func (h *Harness) CrashRelayer(i int) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	node := h.Relayers[i]
+	if node.cancel == nil {
+		return
+	}
+	node.cancel()
+	node.cancel = nil
+	for _, conn := range node.conns {
+		_ = conn.Close()
+	}
+	node.conns = nil
+}
+
+// SetLatency delays every request the relayer sends to chains
+func (h *Harness) SetLatency(i int, latency time.Duration) {
+	atomic.StoreInt64(&h.Relayers[i].latency, int64(latency))
+}
+
+// Deposit deposits ERC20 tokens from admin account and returns deposit nonce
+func (h *Harness) Deposit(source, destination uint8, recipient common.Address, amount *big.Int) (uint64, error) {
+	domain := h.Domains[source]
+	client := domain.Chain.Client(local.EveKp)
+	t := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, evmgaspricer.NewStaticGasPriceDeterminant(client, nil), client)
+	bridgeContract := bridge.NewBridgeContract(client, domain.Config.BridgeAddr, t)
+	_, err := bridgeContract.Erc20Deposit(recipient, amount, domain.Config.Erc20ResourceID, destination, transactor.TransactOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return bridgeContract.GetDepositCount(destination)
+}
+
+// Balance returns ERC20 token balance of the address on the domain
+func (h *Harness) Balance(domainID uint8, address common.Address) (*big.Int, error) {
+	domain := h.Domains[domainID]
+	return erc20.NewERC20Contract(domain.Chain.Client(local.EveKp), domain.Config.Erc20Addr, nil).GetBalance(address)
+}
+
+// Close stops relayers and chains
+func (h *Harness) Close() {
+	for i, node := range h.Relayers {
+		h.CrashRelayer(i)
+		_ = node.db.Close()
+	}
+	h.cancel()
+	for _, domain := range h.Domains {
+		_ = domain.Chain.Close()
+	}
+}
+
+type ProposalKey struct {
+	Source       uint8
+	DepositNonce uint64
+}
+
+type ProposalVotes struct {
+	// Votes is the number of votes that were mined successfully
+	Votes int
+	// Reverted is the number of votes that were mined, but reverted
+	Reverted int
+	// GasUsed is the gas used by all votes
+	GasUsed uint64
+	// WastedGas is the gas used by reverted votes
+	WastedGas uint64
+}
+
+// VoteReport summarizes votes relayers sent to a domain
+type VoteReport struct {
+	Proposals map[ProposalKey]*ProposalVotes
+	WastedGas uint64
+}
+
+// VoteReport collects votes relayers sent to the domain from all its blocks
+func (h *Harness) VoteReport(domainID uint8) (*VoteReport, error) {
+	bridgeABI, err := abi.JSON(strings.NewReader(consts.BridgeABI))
+	if err != nil {
+		return nil, err
+	}
+	relayers := make(map[common.Address]bool)
+	for _, node := range h.Relayers {
+		relayers[node.Keypair.CommonAddress()] = true
+	}
+
+	backend := h.Domains[domainID].Chain.Backend()
+	signer := types.LatestSignerForChainID(backend.Blockchain().Config().ChainID)
+	report := &VoteReport{Proposals: make(map[ProposalKey]*ProposalVotes)}
+	head := backend.Blockchain().CurrentBlock().NumberU64()
+	for n := uint64(0); n <= head; n++ {
+		block, err := backend.BlockByNumber(context.Background(), new(big.Int).SetUint64(n))
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range block.Transactions() {
+			sender, err := types.Sender(signer, tx)
+			if err != nil {
+				return nil, err
+			}
+			if !relayers[sender] || len(tx.Data()) < 4 {
+				continue
+			}
+			method, err := bridgeABI.MethodById(tx.Data()[:4])
+			if err != nil || method.Name != "voteProposal" {
+				continue
+			}
+			args, err := method.Inputs.UnpackValues(tx.Data()[4:])
+			if err != nil {
+				return nil, err
+			}
+			receipt, err := backend.TransactionReceipt(context.Background(), tx.Hash())
+			if err != nil {
+				return nil, err
+			}
+
+			key := ProposalKey{Source: args[0].(uint8), DepositNonce: args[1].(uint64)}
+			votes, ok := report.Proposals[key]
+			if !ok {
+				votes = &ProposalVotes{}
+				report.Proposals[key] = votes
+			}
+			votes.GasUsed += receipt.GasUsed
+			if receipt.Status == types.ReceiptStatusFailed {
+				votes.Reverted++
+				votes.WastedGas += receipt.GasUsed
+				report.WastedGas += receipt.GasUsed
+				continue
+			}
+			votes.Votes++
+		}
+	}
+	return report, nil
+}
+
+func (h *Harness) relayedChain(node *RelayerNode, domain *Domain) (*evm.EVMChain, error) {
+	client, err := h.client(node, domain)
+	if err != nil {
+		return nil, err
+	}
+	t := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, evmgaspricer.NewStaticGasPriceDeterminant(client, nil), client)
+	bridgeContract := bridge.NewBridgeContract(client, domain.Config.BridgeAddr, t)
+
+	depositHandler := listener.NewETHDepositHandler(bridgeContract)
+	depositHandler.RegisterDepositHandler(domain.Config.Erc20HandlerAddr.Hex(), listener.Erc20DepositHandler)
+	eventHandlers := []listener.EventHandler{
+		listener.NewDepositEventHandler(events.NewListener(client), depositHandler, domain.Config.BridgeAddr, domain.ID),
+	}
+	evmListener := listener.NewEVMListener(client, eventHandlers, node.blockstore, domain.ID, BlockRetryInterval, big.NewInt(1), big.NewInt(1))
+
+	mh := executor.NewEVMMessageHandler(bridgeContract)
+	mh.RegisterMessageHandler(domain.Config.Erc20HandlerAddr.Hex(), executor.ERC20MessageHandler)
+	voter := executor.NewVoter(mh, client, bridgeContract)
+
+	// restarted relayer resumes from the last stored block
+	startBlock := big.NewInt(0)
+	if !node.started {
+		startBlock, err = client.LatestBlock()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return evm.NewEVMChain(evmListener, voter, node.blockstore, domain.ID, startBlock, false, !node.started), nil
+}
+
+// client connects relayer to the domain chain over a connection delaying requests
+// by relayer latency
+func (h *Harness) client(node *RelayerNode, domain *Domain) (*evmclient.EVMClient, error) {
+	serverConn, clientConn := net.Pipe()
+	go domain.Chain.Server().ServeCodec(rpc.NewCodec(serverConn), 0)
+	rpcClient, err := rpc.DialIO(h.ctx, clientConn, &latencyWriter{conn: clientConn, latency: &node.latency})
+	if err != nil {
+		return nil, err
+	}
+	node.conns = append(node.conns, clientConn)
+	return evmclient.NewEVMClientFromRPC(rpcClient, node.Keypair), nil
+}
+
+type latencyWriter struct {
+	conn    net.Conn
+	latency *int64
+}
+
+func (w *latencyWriter) Write(p []byte) (int, error) {
+	time.Sleep(time.Duration(atomic.LoadInt64(w.latency)))
+	return w.conn.Write(p)
+}
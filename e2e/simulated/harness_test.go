@@ -0,0 +1,132 @@
+package simulated_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/local"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
+	"github.com/ChainSafe/chainbridge-core/e2e/simulated"
+	"github.com/stretchr/testify/suite"
+)
+
+const settleTime = 2 * time.Second
+
+type HarnessTestSuite struct {
+	suite.Suite
+	harness *simulated.Harness
+	sleep   func(time.Duration)
+}
+
+func TestRunHarnessTestSuite(t *testing.T) {
+	suite.Run(t, new(HarnessTestSuite))
+}
+
+func (s *HarnessTestSuite) SetupSuite() {
+	// shorten random delay relayers wait before voting
+	s.sleep = executor.Sleep
+	executor.Sleep = func(d time.Duration) {
+		time.Sleep(d / 20)
+	}
+}
+func (s *HarnessTestSuite) TearDownSuite() {
+	executor.Sleep = s.sleep
+}
+func (s *HarnessTestSuite) SetupTest() {}
+func (s *HarnessTestSuite) TearDownTest() {
+	if s.harness != nil {
+		s.harness.Close()
+		s.harness = nil
+	}
+}
+
+func (s *HarnessTestSuite) startHarness(relayers int, threshold uint8) {
+	var err error
+	s.harness, err = simulated.NewHarness(simulated.HarnessOpts{
+		Relayers:  relayers,
+		Threshold: threshold,
+		DataDir:   s.T().TempDir(),
+	})
+	s.Nil(err)
+}
+
+func (s *HarnessTestSuite) deposit(amount *big.Int) simulated.ProposalKey {
+	nonce, err := s.harness.Deposit(1, 2, local.BobKp.CommonAddress(), amount)
+	s.Nil(err)
+	return simulated.ProposalKey{Source: 1, DepositNonce: nonce}
+}
+
+func (s *HarnessTestSuite) waitForBalance(amount *big.Int) {
+	s.Eventually(func() bool {
+		balance, err := s.harness.Balance(2, local.BobKp.CommonAddress())
+		return err == nil && balance.Cmp(amount) == 0
+	}, 30*time.Second, 100*time.Millisecond)
+}
+
+func (s *HarnessTestSuite) voteReport() *simulated.VoteReport {
+	report, err := s.harness.VoteReport(2)
+	s.Nil(err)
+	return report
+}
+
+func (s *HarnessTestSuite) TestThreshold_ProposalExecutedWithThresholdVotes() {
+	s.startHarness(3, 2)
+	s.Nil(s.harness.Start())
+	amount := big.NewInt(100)
+
+	key := s.deposit(amount)
+
+	s.waitForBalance(amount)
+	time.Sleep(settleTime)
+	report := s.voteReport()
+	s.Equal(2, report.Proposals[key].Votes)
+	s.LessOrEqual(report.Proposals[key].Reverted, 1)
+}
+
+func (s *HarnessTestSuite) TestCrashedRelayer_ProposalExecutedAfterRestart() {
+	s.startHarness(3, 3)
+	s.Nil(s.harness.Start())
+	s.harness.CrashRelayer(2)
+	amount := big.NewInt(100)
+
+	key := s.deposit(amount)
+
+	time.Sleep(settleTime)
+	balance, err := s.harness.Balance(2, local.BobKp.CommonAddress())
+	s.Nil(err)
+	s.Equal(0, balance.Sign())
+	s.Equal(2, s.voteReport().Proposals[key].Votes)
+
+	s.Nil(s.harness.StartRelayer(2))
+
+	s.waitForBalance(amount)
+	report := s.voteReport()
+	s.Equal(3, report.Proposals[key].Votes)
+	s.Equal(0, report.Proposals[key].Reverted)
+	s.Equal(uint64(0), report.WastedGas)
+}
+
+func (s *HarnessTestSuite) TestLatency_WastedGasReported() {
+	s.startHarness(4, 2)
+	for i := range s.harness.Relayers {
+		s.harness.SetLatency(i, time.Duration(i)*100*time.Millisecond)
+	}
+	s.Nil(s.harness.Start())
+	amount := big.NewInt(100)
+
+	key := s.deposit(amount)
+
+	s.waitForBalance(amount)
+	time.Sleep(settleTime)
+	report := s.voteReport()
+	votes := report.Proposals[key]
+	s.Equal(2, votes.Votes)
+	s.LessOrEqual(votes.Reverted, 2)
+	s.Equal(votes.WastedGas, report.WastedGas)
+	if votes.Reverted == 0 {
+		s.Equal(uint64(0), votes.WastedGas)
+	} else {
+		s.Greater(votes.WastedGas, uint64(0))
+	}
+}
@@ -0,0 +1,135 @@
+package simulated_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ChainSafe/chainbridge-core/chains/evm"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/erc20"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient/simulated"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/cli/local"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	"github.com/ChainSafe/chainbridge-core/keystore"
+	"github.com/ChainSafe/chainbridge-core/lvldb"
+	"github.com/ChainSafe/chainbridge-core/opentelemetry"
+	"github.com/ChainSafe/chainbridge-core/relayer"
+	"github.com/ChainSafe/chainbridge-core/store"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/stretchr/testify/suite"
+)
+
+var (
+	relayerKp = local.AliceKp
+	adminKp   = local.EveKp
+)
+
+type simulatedDomain struct {
+	domainID uint8
+	chain    *simulated.Chain
+	config   local.BridgeConfig
+}
+
+// SimulatedRelayTestSuite relays deposits between two in-process chains
+// with Alice as the relayer and Eve as the admin and depositor
+type SimulatedRelayTestSuite struct {
+	suite.Suite
+	ctx     context.Context
+	cancel  context.CancelFunc
+	domain1 *simulatedDomain
+	domain2 *simulatedDomain
+}
+
+func TestRunSimulatedRelayTestSuite(t *testing.T) {
+	suite.Run(t, new(SimulatedRelayTestSuite))
+}
+
+func (s *SimulatedRelayTestSuite) SetupSuite()    {}
+func (s *SimulatedRelayTestSuite) TearDownSuite() {}
+func (s *SimulatedRelayTestSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.domain1 = s.setupDomain(1)
+	s.domain2 = s.setupDomain(2)
+
+	db, err := lvldb.NewLvlDB(s.T().TempDir())
+	s.Nil(err)
+	blockstore := store.NewBlockStore(db)
+	r := relayer.NewRelayer(
+		[]relayer.RelayedChain{s.relayedChain(s.domain1, blockstore), s.relayedChain(s.domain2, blockstore)},
+		&opentelemetry.ConsoleTelemetry{},
+	)
+	go r.Start(s.ctx, make(chan error, 1))
+}
+func (s *SimulatedRelayTestSuite) TearDownTest() {
+	s.cancel()
+	_ = s.domain1.chain.Close()
+	_ = s.domain2.chain.Close()
+}
+
+func (s *SimulatedRelayTestSuite) setupDomain(domainID uint8) *simulatedDomain {
+	balance := new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil)
+	chain, err := simulated.NewChain(core.GenesisAlloc{
+		adminKp.CommonAddress():   {Balance: balance},
+		relayerKp.CommonAddress(): {Balance: balance},
+	})
+	s.Nil(err)
+	config, err := local.SetupEVMBridge(
+		chain.Client(adminKp), evmtransaction.NewTransaction, domainID, big.NewInt(1), adminKp.CommonAddress(), local.DefaultRelayerAddresses,
+	)
+	s.Nil(err)
+	chain.MineEvery(s.ctx, 50*time.Millisecond)
+	return &simulatedDomain{domainID: domainID, chain: chain, config: config}
+}
+
+func (s *SimulatedRelayTestSuite) relayedChain(domain *simulatedDomain, blockstore *store.BlockStore) relayer.RelayedChain {
+	client := domain.chain.Client(relayerKp)
+	t := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, evmgaspricer.NewStaticGasPriceDeterminant(client, nil), client)
+	bridgeContract := bridge.NewBridgeContract(client, domain.config.BridgeAddr, t)
+
+	depositHandler := listener.NewETHDepositHandler(bridgeContract)
+	depositHandler.RegisterDepositHandler(domain.config.Erc20HandlerAddr.Hex(), listener.Erc20DepositHandler)
+	eventHandlers := []listener.EventHandler{
+		listener.NewDepositEventHandler(events.NewListener(client), depositHandler, domain.config.BridgeAddr, domain.domainID),
+	}
+	evmListener := listener.NewEVMListener(client, eventHandlers, blockstore, domain.domainID, 50*time.Millisecond, big.NewInt(1), big.NewInt(1))
+
+	mh := executor.NewEVMMessageHandler(bridgeContract)
+	mh.RegisterMessageHandler(domain.config.Erc20HandlerAddr.Hex(), executor.ERC20MessageHandler)
+	voter := executor.NewVoter(mh, client, bridgeContract)
+	voter.SetRelayerAddresses(local.DefaultRelayerAddresses)
+
+	head, err := client.LatestBlock()
+	s.Nil(err)
+	return evm.NewEVMChain(evmListener, voter, blockstore, domain.domainID, head, false, true)
+}
+
+func (s *SimulatedRelayTestSuite) TestErc20Deposit() {
+	dstAddr := keystore.TestKeyRing.EthereumKeys[keystore.BobKey].CommonAddress()
+	client1 := s.domain1.chain.Client(adminKp)
+	t := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, evmgaspricer.NewStaticGasPriceDeterminant(client1, nil), client1)
+	bridgeContract1 := bridge.NewBridgeContract(client1, s.domain1.config.BridgeAddr, t)
+	erc20Contract1 := erc20.NewERC20Contract(client1, s.domain1.config.Erc20Addr, t)
+	erc20Contract2 := erc20.NewERC20Contract(s.domain2.chain.Client(adminKp), s.domain2.config.Erc20Addr, nil)
+	senderBalanceBefore, err := erc20Contract1.GetBalance(adminKp.CommonAddress())
+	s.Nil(err)
+
+	amount := big.NewInt(1000000)
+	_, err = bridgeContract1.Erc20Deposit(dstAddr, amount, s.domain1.config.Erc20ResourceID, s.domain2.domainID, transactor.TransactOptions{})
+	s.Nil(err)
+
+	s.Eventually(func() bool {
+		balance, err := erc20Contract2.GetBalance(dstAddr)
+		return err == nil && balance.Cmp(amount) == 0
+	}, 30*time.Second, 100*time.Millisecond)
+	senderBalanceAfter, err := erc20Contract1.GetBalance(adminKp.CommonAddress())
+	s.Nil(err)
+	s.Equal(new(big.Int).Sub(senderBalanceBefore, amount), senderBalanceAfter)
+}
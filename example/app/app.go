@@ -6,6 +6,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"os"
 	"os/signal"
 	"syscall"
@@ -13,17 +14,23 @@ import (
 	secp256k1 "github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/ChainSafe/chainbridge-core/chains/evm"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/contracts/bridge"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/events"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmclient"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmgaspricer"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/evmtransaction"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/budget"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/keypool"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/calls/transactor/signAndSend"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/executor"
 	"github.com/ChainSafe/chainbridge-core/chains/evm/listener"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/verifier"
+	"github.com/ChainSafe/chainbridge-core/chains/evm/watcher"
 	"github.com/ChainSafe/chainbridge-core/config"
 	"github.com/ChainSafe/chainbridge-core/config/chain"
 	secp256k12 "github.com/ChainSafe/chainbridge-core/crypto/secp256k1"
-	"github.com/ChainSafe/chainbridge-core/e2e/dummy"
 	"github.com/ChainSafe/chainbridge-core/flags"
 	"github.com/ChainSafe/chainbridge-core/lvldb"
 	"github.com/ChainSafe/chainbridge-core/opentelemetry"
@@ -34,7 +41,15 @@ import (
 	"github.com/spf13/viper"
 )
 
+// gasPricePoller is implemented by gas pricers that refresh prices in the background
+type gasPricePoller interface {
+	Start(ctx context.Context)
+}
+
 func Run() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	configuration, err := config.GetConfig(viper.GetString(flags.ConfigFlagName))
 	if err != nil {
 		panic(err)
@@ -45,8 +60,18 @@ func Run() error {
 		panic(err)
 	}
 	blockstore := store.NewBlockStore(db)
+	nonceStore := store.NewNonceStore(db)
+	spendingStore := store.NewSpendingStore(db)
 
 	chains := []relayer.RelayedChain{}
+	telemetry := &opentelemetry.ConsoleTelemetry{}
+	gasPricers := evmgaspricer.NewDefaultRegistry()
+	voters := []*executor.EVMVoter{}
+	depositVerifier := verifier.NewDepositVerifier()
+	bridges := make(map[uint8]*bridge.BridgeContract)
+	sourceClients := make(map[uint8]*evmclient.EVMClient)
+	sourceConfirmations := make(map[uint8]*big.Int)
+	watchers := make(map[uint8]*watcher.Watcher)
 	for _, chainConfig := range configuration.ChainConfigs {
 		switch chainConfig["type"] {
 		case "evm":
@@ -63,37 +88,246 @@ func Run() error {
 
 				kp := secp256k12.NewKeypair(*privateKey)
 
-				client, err := evmclient.NewEVMClient(config.GeneralChainConfig.Endpoint, kp)
+				rateLimit := evmclient.DefaultRateLimitOpts
+				rateLimit.RequestsPerSecond = config.RequestsPerSecond
+				rateLimit.MaxRetries = config.MaxRetries
+				rateLimit.Metrics = telemetry
+
+				var client *evmclient.EVMClient
+				if len(config.GeneralChainConfig.Endpoints) > 0 {
+					endpoints := make([]evmclient.Endpoint, len(config.GeneralChainConfig.Endpoints))
+					for i, e := range config.GeneralChainConfig.Endpoints {
+						endpoints[i] = evmclient.Endpoint{URL: e.URL, Weight: e.Weight}
+					}
+					failoverOpts := evmclient.DefaultFailoverOpts
+					failoverOpts.RateLimit = rateLimit
+					client, err = evmclient.NewFailoverEVMClient(ctx, endpoints, kp, failoverOpts)
+				} else if len(config.Faults) > 0 {
+					faults := evmclient.DefaultFaultOpts
+					for _, f := range config.Faults {
+						faults.Rules = append(faults.Rules, evmclient.FaultRule{
+							Fault:       evmclient.Fault(f.Type),
+							Probability: f.Probability,
+							Every:       f.Every,
+							Methods:     f.Methods,
+						})
+					}
+					log.Warn().Msgf("Injecting faults into requests to chain %d", *config.GeneralChainConfig.Id)
+					client, err = evmclient.NewFaultInjectingEVMClient(config.GeneralChainConfig.Endpoint, kp, rateLimit, faults)
+				} else {
+					client, err = evmclient.NewRateLimitedEVMClient(config.GeneralChainConfig.Endpoint, kp, rateLimit)
+				}
+				if err != nil {
+					panic(err)
+				}
+				if config.CallBatchWindow > 0 {
+					client.EnableCallBatching(config.CallBatchWindow, evmclient.DefaultMaxCallBatchSize)
+				}
+				profile, err := client.ProbeProfile(ctx)
 				if err != nil {
 					panic(err)
 				}
+				err = client.SetNonceStore(nonceStore)
+				if err != nil {
+					panic(err)
+				}
+
+				gasPricer, err := gasPricers.GasPricer(config.GasPricer.Type, client, &evmgaspricer.GasPricerOpts{
+					UpperLimitFeePerGas: config.MaxGasPrice,
+					GasPriceFactor:      config.GasMultiplier,
+				}, config.GasPricer.Options)
+				if err != nil {
+					panic(err)
+				}
+				if poller, ok := gasPricer.(gasPricePoller); ok {
+					poller.Start(ctx)
+				}
+				// arbitrum gas estimates already include L1 data cost, so execution cost is the total cost
+				var costEstimator signAndSend.CostEstimator = &evmgaspricer.ExecutionCostEstimator{}
+				if config.L1FeeOracle == "optimism" {
+					l2GasPricer := evmgaspricer.NewL2GasPriceDeterminant(gasPricer, evmgaspricer.NewOptimismL1FeeOracle(client))
+					gasPricer, costEstimator = l2GasPricer, l2GasPricer
+				}
+				monitorOpts := signAndSend.DefaultMonitorOpts
+				monitorOpts.ResubmitTimeout = config.ResubmitTimeout
+				monitorOpts.UpperLimitFeePerGas = config.MaxGasPrice
+				gasLimitEstimator := transactor.NewGasLimitEstimator(client, config.GasLimitMultiplier, config.GasLimitCeiling.Uint64(), config.GasLimit.Uint64())
+				monitoredTransactor := signAndSend.NewMonitoredTransactor(evmtransaction.NewTransaction, gasPricer, client, monitorOpts)
+				monitoredTransactor.SetGasLimitEstimator(gasLimitEstimator)
+				client.SetNonceGapFiller(monitoredTransactor)
+				monitoredTransactor.SetCostTracking(*config.GeneralChainConfig.Id, costEstimator, telemetry)
+				var t transactor.Transactor = monitoredTransactor
+				relayerAddresses := []common.Address{client.RelayerAddress()}
+				var pool *keypool.KeyPool
+				if len(config.SenderKeys) > 0 {
+					senders := []keypool.Sender{{Address: client.RelayerAddress(), Transactor: t}}
+					for _, key := range config.SenderKeys {
+						senderPrivateKey, err := secp256k1.HexToECDSA(key)
+						if err != nil {
+							panic(err)
+						}
+
+						senderClient := client.WithSigner(secp256k12.NewKeypair(*senderPrivateKey))
+						err = senderClient.SetNonceStore(nonceStore)
+						if err != nil {
+							panic(err)
+						}
+						senderTransactor := signAndSend.NewMonitoredTransactor(evmtransaction.NewTransaction, gasPricer, senderClient, monitorOpts)
+						senderTransactor.SetGasLimitEstimator(gasLimitEstimator)
+						senderClient.SetNonceGapFiller(senderTransactor)
+						senderTransactor.SetCostTracking(*config.GeneralChainConfig.Id, costEstimator, telemetry)
+						senders = append(senders, keypool.Sender{
+							Address:    senderClient.RelayerAddress(),
+							Transactor: senderTransactor,
+						})
+					}
 
-				dummyGasPricer := dummy.NewStaticGasPriceDeterminant(client, nil)
-				t := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, dummyGasPricer, client)
-				bridgeContract := bridge.NewBridgeContract(client, common.HexToAddress(config.Bridge), t)
+					pool, err = keypool.NewKeyPool(senders, client)
+					if err != nil {
+						panic(err)
+					}
+					pool.LogBalances(ctx)
+					relayerAddresses = pool.Addresses()
+					t = pool
+				}
+				if config.HourlySpendingBudget != nil || config.DailySpendingBudget != nil {
+					guard, err := budget.NewSpendingGuard(t, client, spendingStore, *config.GeneralChainConfig.Id, budget.Budget{
+						Hourly: config.HourlySpendingBudget,
+						Daily:  config.DailySpendingBudget,
+					}, &budget.LogAlertReporter{})
+					if err != nil {
+						panic(err)
+					}
+					guard.SetCostEstimator(monitoredTransactor)
+					if viper.GetBool(flags.ResumeSpendingFlagName) && guard.Paused() {
+						err = guard.Resume()
+						if err != nil {
+							panic(err)
+						}
+					}
+					t = guard
+				}
+				var bridgeClient calls.ContractCallerDispatcher = client
+				var eventClient events.ChainClient = client
+				if len(config.QuorumEndpoints) > 0 {
+					readers := make([]evmclient.QuorumReader, len(config.QuorumEndpoints))
+					for i, endpoint := range config.QuorumEndpoints {
+						readers[i], err = evmclient.NewRateLimitedEVMClient(endpoint, nil, rateLimit)
+						if err != nil {
+							panic(err)
+						}
+					}
+					quorumClient, err := evmclient.NewQuorumClient(client, readers, config.Quorum, *config.GeneralChainConfig.Id, telemetry)
+					if err != nil {
+						panic(err)
+					}
+					bridgeClient, eventClient = quorumClient, quorumClient
+				}
+				bridgeContract := bridge.NewBridgeContract(bridgeClient, common.HexToAddress(config.Bridge), t)
+				cachedBridge := bridge.NewCachedBridgeContract(bridgeContract, bridge.CacheOpts{
+					HandlerTTL:   config.BridgeCacheTTL,
+					ThresholdTTL: config.BridgeCacheTTL,
+					RelayerTTL:   config.BridgeCacheTTL,
+				})
+				if pool != nil {
+					unregistered, err := pool.UnregisteredKeys(cachedBridge)
+					if err != nil {
+						panic(err)
+					}
+					if len(unregistered) > 0 {
+						panic(fmt.Errorf("sender keys %v are not registered as relayers", unregistered))
+					}
+				}
 
-				depositHandler := listener.NewETHDepositHandler(bridgeContract)
+				depositHandler := listener.NewETHDepositHandler(cachedBridge)
 				depositHandler.RegisterDepositHandler(config.Erc20Handler, listener.Erc20DepositHandler)
 				depositHandler.RegisterDepositHandler(config.Erc721Handler, listener.Erc721DepositHandler)
 				depositHandler.RegisterDepositHandler(config.GenericHandler, listener.GenericDepositHandler)
-				eventListener := events.NewListener(client)
+				eventListener := events.NewListener(eventClient)
 				eventHandlers := make([]listener.EventHandler, 0)
 				eventHandlers = append(eventHandlers, listener.NewDepositEventHandler(eventListener, depositHandler, common.HexToAddress(config.Bridge), *config.GeneralChainConfig.Id))
-				evmListener := listener.NewEVMListener(client, eventHandlers, blockstore, *config.GeneralChainConfig.Id, config.BlockRetryInterval, config.BlockConfirmations, config.BlockInterval)
+				eventHandlers = append(eventHandlers, listener.NewBridgeCacheEventHandler(eventListener, cachedBridge, common.HexToAddress(config.Bridge)))
+				bridges[*config.GeneralChainConfig.Id] = bridgeContract
+				sourceClients[*config.GeneralChainConfig.Id] = client
+				sourceConfirmations[*config.GeneralChainConfig.Id] = config.BlockConfirmations
+
+				if config.VerificationEndpoint != "" {
+					verificationClient, err := evmclient.NewRateLimitedEVMClient(config.VerificationEndpoint, kp, rateLimit)
+					if err != nil {
+						panic(err)
+					}
 
-				mh := executor.NewEVMMessageHandler(bridgeContract)
+					verificationBridge := bridge.NewBridgeContract(verificationClient, common.HexToAddress(config.Bridge), nil)
+					verificationDepositHandler := listener.NewETHDepositHandler(verificationBridge)
+					verificationDepositHandler.RegisterDepositHandler(config.Erc20Handler, listener.Erc20DepositHandler)
+					verificationDepositHandler.RegisterDepositHandler(config.Erc721Handler, listener.Erc721DepositHandler)
+					verificationDepositHandler.RegisterDepositHandler(config.GenericHandler, listener.GenericDepositHandler)
+					var fetcher verifier.MessageFetcher
+					if config.ReceiptProofVerification {
+						fetcher = verifier.NewReceiptProofVerifier(client, verificationClient, verificationDepositHandler, common.HexToAddress(config.Bridge))
+					} else {
+						fetcher = verifier.NewSourceVerifier(verificationClient, verificationDepositHandler, verificationBridge, common.HexToAddress(config.Bridge))
+					}
+					depositVerifier.RegisterSource(*config.GeneralChainConfig.Id, fetcher)
+				}
+
+				mh := executor.NewEVMMessageHandler(cachedBridge)
 				mh.RegisterMessageHandler(config.Erc20Handler, executor.ERC20MessageHandler)
 				mh.RegisterMessageHandler(config.Erc721Handler, executor.ERC721MessageHandler)
 				mh.RegisterMessageHandler(config.GenericHandler, executor.GenericMessageHandler)
 
-				var evmVoter *executor.EVMVoter
-				evmVoter, err = executor.NewVoterWithSubscription(mh, client, bridgeContract)
-				if err != nil {
-					log.Error().Msgf("failed creating voter with subscription: %s. Falling back to default voter.", err.Error())
-					evmVoter = executor.NewVoter(mh, client, bridgeContract)
+				var proposalExecutor evm.ProposalExecutor
+				switch {
+				case viper.GetBool(flags.WatcherFlagName):
+					w := watcher.NewWatcher(mh, eventListener, client, common.HexToAddress(config.Bridge), *config.GeneralChainConfig.Id, &watcher.LogAlarmReporter{})
+					if config.AdminKey != "" {
+						adminPrivateKey, err := secp256k1.HexToECDSA(config.AdminKey)
+						if err != nil {
+							panic(err)
+						}
+
+						adminClient, err := evmclient.NewEVMClient(config.GeneralChainConfig.Endpoint, secp256k12.NewKeypair(*adminPrivateKey))
+						if err != nil {
+							panic(err)
+						}
+						adminTransactor := signAndSend.NewSignAndSendTransactor(evmtransaction.NewTransaction, gasPricer, adminClient)
+						w.SetPauser(bridge.NewBridgeContract(adminClient, common.HexToAddress(config.Bridge), adminTransactor))
+					}
+					watchers[*config.GeneralChainConfig.Id] = w
+					eventHandlers = append(eventHandlers, w)
+					proposalExecutor = w
+				case viper.GetBool(flags.ShadowFlagName):
+					if len(config.ShadowRelayers) == 0 {
+						panic(fmt.Errorf("shadowRelayers have to be set to run shadow voter on chain %d", *config.GeneralChainConfig.Id))
+					}
+					shadowRelayers := make([]common.Address, len(config.ShadowRelayers))
+					for i, relayer := range config.ShadowRelayers {
+						shadowRelayers[i] = common.HexToAddress(relayer)
+					}
+					proposalExecutor = executor.NewShadowVoter(mh, cachedBridge, shadowRelayers, &executor.LogShadowReporter{})
+				default:
+					evmVoter := executor.NewVoter(mh, client, cachedBridge)
+					if profile.Subscriptions {
+						subscriptionVoter, err := executor.NewVoterWithSubscription(mh, client, cachedBridge)
+						if err != nil {
+							log.Error().Msgf("failed creating voter with subscription: %s. Falling back to default voter.", err.Error())
+						} else {
+							evmVoter = subscriptionVoter
+						}
+					}
+					if config.AsyncVoting {
+						evmVoter.EnableAsyncVoting()
+					}
+					evmVoter.SetRelayerAddresses(relayerAddresses)
+					voters = append(voters, evmVoter)
+					proposalExecutor = evmVoter
 				}
 
-				chain := evm.NewEVMChain(evmListener, evmVoter, blockstore, *config.GeneralChainConfig.Id, config.StartBlock, config.GeneralChainConfig.LatestBlock, config.GeneralChainConfig.FreshStart)
+				evmListener := listener.NewEVMListener(client, eventHandlers, blockstore, *config.GeneralChainConfig.Id, config.BlockRetryInterval, config.BlockConfirmations, config.BlockInterval)
+				if profile.FinalityTag {
+					evmListener.SetFinalityClient(client)
+				}
+				chain := evm.NewEVMChain(evmListener, proposalExecutor, blockstore, *config.GeneralChainConfig.Id, config.StartBlock, config.GeneralChainConfig.LatestBlock, config.GeneralChainConfig.FreshStart)
 
 				chains = append(chains, chain)
 			}
@@ -102,14 +336,26 @@ func Run() error {
 		}
 	}
 
+	if depositVerifier.Enabled() {
+		for _, v := range voters {
+			v.SetVerifier(depositVerifier)
+		}
+	}
+
+	for domainID, w := range watchers {
+		for sourceID, b := range bridges {
+			if sourceID != domainID {
+				w.RegisterSource(sourceID, b, sourceClients[sourceID], sourceConfirmations[sourceID])
+			}
+		}
+	}
+
 	r := relayer.NewRelayer(
 		chains,
-		&opentelemetry.ConsoleTelemetry{},
+		telemetry,
 	)
 
 	errChn := make(chan error)
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
 	go r.Start(ctx, errChn)
 
 	sysErr := make(chan os.Signal, 1)
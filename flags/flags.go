@@ -7,11 +7,14 @@ import (
 
 var (
 	// Flags for running the Chainbridge app
-	ConfigFlagName      = "config"
-	KeystoreFlagName    = "keystore"
-	BlockstoreFlagName  = "blockstore"
-	FreshStartFlagName  = "fresh"
-	LatestBlockFlagName = "latest"
+	ConfigFlagName         = "config"
+	KeystoreFlagName       = "keystore"
+	BlockstoreFlagName     = "blockstore"
+	FreshStartFlagName     = "fresh"
+	LatestBlockFlagName    = "latest"
+	ShadowFlagName         = "shadow"
+	WatcherFlagName        = "watcher"
+	ResumeSpendingFlagName = "resume-spending"
 )
 
 func BindFlags(rootCMD *cobra.Command) {
@@ -27,6 +30,15 @@ func BindFlags(rootCMD *cobra.Command) {
 	rootCMD.PersistentFlags().Bool(LatestBlockFlagName, false, "Overrides blockstore and start block, starts from latest block (default: false)")
 	_ = viper.BindPFlag(LatestBlockFlagName, rootCMD.PersistentFlags().Lookup(LatestBlockFlagName))
 
+	rootCMD.PersistentFlags().Bool(ShadowFlagName, false, "Builds proposals and compares them with on-chain votes of other relayers without sending transactions (default: false)")
+	_ = viper.BindPFlag(ShadowFlagName, rootCMD.PersistentFlags().Lookup(ShadowFlagName))
+
+	rootCMD.PersistentFlags().Bool(WatcherFlagName, false, "Audits votes of relayers against deposits on source chains without voting (default: false)")
+	_ = viper.BindPFlag(WatcherFlagName, rootCMD.PersistentFlags().Lookup(WatcherFlagName))
+
+	rootCMD.PersistentFlags().Bool(ResumeSpendingFlagName, false, "Resumes sending transactions paused after spending budget was exceeded (default: false)")
+	_ = viper.BindPFlag(ResumeSpendingFlagName, rootCMD.PersistentFlags().Lookup(ResumeSpendingFlagName))
+
 	rootCMD.PersistentFlags().String(KeystoreFlagName, "./keys", "Path to keystore directory")
 	_ = viper.BindPFlag(KeystoreFlagName, rootCMD.PersistentFlags().Lookup(KeystoreFlagName))
 }
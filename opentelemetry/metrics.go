@@ -14,7 +14,11 @@ import (
 )
 
 type ChainbridgeMetrics struct {
-	DepositEventCount metric.Int64Counter
+	DepositEventCount        metric.Int64Counter
+	EstimatedTransactionCost metric.Float64Counter
+	QuorumDisagreementCount  metric.Int64Counter
+	RPCRetryCount            metric.Int64Counter
+	RPCThrottleCount         metric.Int64Counter
 }
 
 // NewChainbridgeMetrics creates an instance of ChainbridgeMetrics
@@ -25,6 +29,22 @@ func NewChainbridgeMetrics(meter metric.Meter) *ChainbridgeMetrics {
 			"chainbridge.DepositEventCount",
 			metric.WithDescription("Number of deposit events across all chains"),
 		),
+		EstimatedTransactionCost: metric.Must(meter).NewFloat64Counter(
+			"chainbridge.EstimatedTransactionCost",
+			metric.WithDescription("Estimated cost in Gwei of transactions sent by the relayer, including L1 data fees on rollups"),
+		),
+		QuorumDisagreementCount: metric.Must(meter).NewInt64Counter(
+			"chainbridge.QuorumDisagreementCount",
+			metric.WithDescription("Number of quorum reads on which endpoints returned different results"),
+		),
+		RPCRetryCount: metric.Must(meter).NewInt64Counter(
+			"chainbridge.RPCRetryCount",
+			metric.WithDescription("Number of RPC requests retried after being throttled or failing with transient errors"),
+		),
+		RPCThrottleCount: metric.Must(meter).NewInt64Counter(
+			"chainbridge.RPCThrottleCount",
+			metric.WithDescription("Number of RPC requests throttled by endpoints"),
+		),
 	}
 }
 
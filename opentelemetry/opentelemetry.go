@@ -2,10 +2,12 @@ package opentelemetry
 
 import (
 	"context"
+	"math/big"
 	"net/url"
 
 	"github.com/ChainSafe/chainbridge-core/relayer/message"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 )
 
@@ -44,6 +46,30 @@ func (t *OpenTelemetry) TrackDepositMessage(m *message.Message) {
 	t.metrics.DepositEventCount.Add(context.Background(), 1)
 }
 
+// TrackTransactionCost sends estimated cost of the transaction sent on the domain
+// to OpenTelemetry collector
+func (t *OpenTelemetry) TrackTransactionCost(domainID uint8, cost *big.Int) {
+	t.metrics.EstimatedTransactionCost.Add(context.Background(), weiToGwei(cost), attribute.Int("domainID", int(domainID)))
+}
+
+// TrackQuorumDisagreement sends disagreement of quorum endpoints on the domain
+// to OpenTelemetry collector
+func (t *OpenTelemetry) TrackQuorumDisagreement(domainID uint8, method string) {
+	t.metrics.QuorumDisagreementCount.Add(context.Background(), 1, attribute.Int("domainID", int(domainID)), attribute.String("method", method))
+}
+
+// TrackRPCRetry sends retry of the request sent to the RPC endpoint
+// to OpenTelemetry collector
+func (t *OpenTelemetry) TrackRPCRetry(endpoint string) {
+	t.metrics.RPCRetryCount.Add(context.Background(), 1, attribute.String("endpoint", endpoint))
+}
+
+// TrackRPCThrottle sends throttling of the request by the RPC endpoint
+// to OpenTelemetry collector
+func (t *OpenTelemetry) TrackRPCThrottle(endpoint string) {
+	t.metrics.RPCThrottleCount.Add(context.Background(), 1, attribute.String("endpoint", endpoint))
+}
+
 // ConsoleTelemetry is telemetry that logs metrics and should be used
 // when metrics sending to OpenTelemetry should be disabled
 type ConsoleTelemetry struct{}
@@ -51,3 +77,24 @@ type ConsoleTelemetry struct{}
 func (t *ConsoleTelemetry) TrackDepositMessage(m *message.Message) {
 	log.Info().Msgf("Deposit message: %+v", m)
 }
+
+func (t *ConsoleTelemetry) TrackTransactionCost(domainID uint8, cost *big.Int) {
+	log.Info().Msgf("Estimated cost of transaction on domain %d: %v Gwei", domainID, weiToGwei(cost))
+}
+
+func (t *ConsoleTelemetry) TrackQuorumDisagreement(domainID uint8, method string) {
+	log.Info().Msgf("Quorum endpoints on domain %d disagree on %s", domainID, method)
+}
+
+func (t *ConsoleTelemetry) TrackRPCRetry(endpoint string) {
+	log.Debug().Msgf("Retrying RPC request to %s", endpoint)
+}
+
+func (t *ConsoleTelemetry) TrackRPCThrottle(endpoint string) {
+	log.Info().Msgf("RPC request throttled by %s", endpoint)
+}
+
+func weiToGwei(wei *big.Int) float64 {
+	gwei, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(1e9)).Float64()
+	return gwei
+}
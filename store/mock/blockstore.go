@@ -1,8 +1,8 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: blockstore/blockstore.go
+// Source: ./store/store.go
 
-// Package mock_blockstore is a generated GoMock package.
-package mock_blockstore
+// Package mock_store is a generated GoMock package.
+package mock_store
 
 import (
 	reflect "reflect"
@@ -33,6 +33,20 @@ func (m *MockKeyValueReaderWriter) EXPECT() *MockKeyValueReaderWriterMockRecorde
 	return m.recorder
 }
 
+// DeleteByKey mocks base method.
+func (m *MockKeyValueReaderWriter) DeleteByKey(key []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByKey", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByKey indicates an expected call of DeleteByKey.
+func (mr *MockKeyValueReaderWriterMockRecorder) DeleteByKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockKeyValueReaderWriter)(nil).DeleteByKey), key)
+}
+
 // GetByKey mocks base method.
 func (m *MockKeyValueReaderWriter) GetByKey(key []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -123,6 +137,20 @@ func (m *MockKeyValueWriter) EXPECT() *MockKeyValueWriterMockRecorder {
 	return m.recorder
 }
 
+// DeleteByKey mocks base method.
+func (m *MockKeyValueWriter) DeleteByKey(key []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByKey", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByKey indicates an expected call of DeleteByKey.
+func (mr *MockKeyValueWriterMockRecorder) DeleteByKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByKey", reflect.TypeOf((*MockKeyValueWriter)(nil).DeleteByKey), key)
+}
+
 // SetByKey mocks base method.
 func (m *MockKeyValueWriter) SetByKey(key, value []byte) error {
 	m.ctrl.T.Helper()
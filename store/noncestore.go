@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/syndtr/goleveldb/leveldb"
 )
 
@@ -53,3 +54,35 @@ func (ns *NonceStore) GetNonce(chainID *big.Int) (*big.Int, error) {
 	block := big.NewInt(0).SetBytes(v)
 	return block, nil
 }
+
+// StoreAccountNonce stores next nonce of the account per chainID
+func (ns *NonceStore) StoreAccountNonce(chainID *big.Int, account common.Address, nonce *big.Int) error {
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:account:%s:nonce", chainID.Int64(), account.Hex())
+	key.WriteString(keyS)
+
+	err := ns.db.SetByKey(key.Bytes(), nonce.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetAccountNonce queries the nonce store and returns stored next nonce of the account
+func (ns *NonceStore) GetAccountNonce(chainID *big.Int, account common.Address) (*big.Int, error) {
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:account:%s:nonce", chainID.Int64(), account.Hex())
+	key.WriteString(keyS)
+
+	v, err := ns.db.GetByKey(key.Bytes())
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return big.NewInt(0), nil
+		}
+		return nil, err
+	}
+
+	nonce := big.NewInt(0).SetBytes(v)
+	return nonce, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ChainSafe/chainbridge-core/store"
 	mock_store "github.com/ChainSafe/chainbridge-core/store/mock"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -77,3 +78,32 @@ func (s *NonceStoreTestSuite) TestGetNonce_SuccessfulFetch() {
 	s.Nil(err)
 	s.Equal(block, big.NewInt(5))
 }
+
+func (s *NonceStoreTestSuite) TestStoreAccountNonce_SuccessfulStore() {
+	key := "chain:1:account:0x0000000000000000000000000000000000000005:nonce"
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), []byte{5}).Return(nil)
+
+	err := s.nonceStore.StoreAccountNonce(big.NewInt(1), common.HexToAddress("0x5"), big.NewInt(5))
+
+	s.Nil(err)
+}
+
+func (s *NonceStoreTestSuite) TestGetAccountNonce_NonceNotFound() {
+	key := "chain:1:account:0x0000000000000000000000000000000000000005:nonce"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, leveldb.ErrNotFound)
+
+	nonce, err := s.nonceStore.GetAccountNonce(big.NewInt(1), common.HexToAddress("0x5"))
+
+	s.Nil(err)
+	s.Equal(nonce, big.NewInt(0))
+}
+
+func (s *NonceStoreTestSuite) TestGetAccountNonce_SuccessfulFetch() {
+	key := "chain:1:account:0x0000000000000000000000000000000000000005:nonce"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return([]byte{5}, nil)
+
+	nonce, err := s.nonceStore.GetAccountNonce(big.NewInt(1), common.HexToAddress("0x5"))
+
+	s.Nil(err)
+	s.Equal(nonce, big.NewInt(5))
+}
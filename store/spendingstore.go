@@ -0,0 +1,157 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type SpendingStore struct {
+	db KeyValueReaderWriter
+}
+
+func NewSpendingStore(db KeyValueReaderWriter) *SpendingStore {
+	return &SpendingStore{
+		db: db,
+	}
+}
+
+// StoreSpending stores amount spent on transaction fees of the domain in the budget period window
+func (ss *SpendingStore) StoreSpending(domainID uint8, period string, window int64, spent *big.Int) error {
+	windows, err := ss.windows(domainID, period)
+	if err != nil {
+		return err
+	}
+	if !containsWindow(windows, window) {
+		err = ss.storeWindows(domainID, period, append(windows, window))
+		if err != nil {
+			return err
+		}
+	}
+
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:spending:%s:%d", domainID, period, window)
+	key.WriteString(keyS)
+
+	return ss.db.SetByKey(key.Bytes(), spent.Bytes())
+}
+
+// PruneSpending deletes spending of the domain in the budget period windows before the window
+func (ss *SpendingStore) PruneSpending(domainID uint8, period string, before int64) error {
+	windows, err := ss.windows(domainID, period)
+	if err != nil {
+		return err
+	}
+
+	kept := make([]int64, 0, len(windows))
+	for _, window := range windows {
+		if window >= before {
+			kept = append(kept, window)
+			continue
+		}
+		key := bytes.Buffer{}
+		keyS := fmt.Sprintf("chain:%d:spending:%s:%d", domainID, period, window)
+		key.WriteString(keyS)
+		err = ss.db.DeleteByKey(key.Bytes())
+		if err != nil {
+			return err
+		}
+	}
+	return ss.storeWindows(domainID, period, kept)
+}
+
+// windows returns budget period windows spending of the domain is stored for
+func (ss *SpendingStore) windows(domainID uint8, period string) ([]int64, error) {
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:spending:%s:windows", domainID, period)
+	key.WriteString(keyS)
+
+	v, err := ss.db.GetByKey(key.Bytes())
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return []int64{}, nil
+		}
+		return nil, err
+	}
+
+	windows := make([]int64, 0, len(v)/8)
+	for i := 0; i+8 <= len(v); i += 8 {
+		windows = append(windows, int64(binary.BigEndian.Uint64(v[i:i+8])))
+	}
+	return windows, nil
+}
+
+func (ss *SpendingStore) storeWindows(domainID uint8, period string, windows []int64) error {
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:spending:%s:windows", domainID, period)
+	key.WriteString(keyS)
+
+	value := make([]byte, 8*len(windows))
+	for i, window := range windows {
+		binary.BigEndian.PutUint64(value[8*i:], uint64(window))
+	}
+	return ss.db.SetByKey(key.Bytes(), value)
+}
+
+func containsWindow(windows []int64, window int64) bool {
+	for _, w := range windows {
+		if w == window {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSpending returns amount spent on transaction fees of the domain in the budget period window
+func (ss *SpendingStore) GetSpending(domainID uint8, period string, window int64) (*big.Int, error) {
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:spending:%s:%d", domainID, period, window)
+	key.WriteString(keyS)
+
+	v, err := ss.db.GetByKey(key.Bytes())
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return big.NewInt(0), nil
+		}
+		return nil, err
+	}
+
+	return big.NewInt(0).SetBytes(v), nil
+}
+
+// StorePaused stores whether sending transactions on the domain is paused
+func (ss *SpendingStore) StorePaused(domainID uint8, paused bool) error {
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:spending:paused", domainID)
+	key.WriteString(keyS)
+
+	value := []byte{0}
+	if paused {
+		value = []byte{1}
+	}
+	return ss.db.SetByKey(key.Bytes(), value)
+}
+
+// GetPaused returns whether sending transactions on the domain is paused
+func (ss *SpendingStore) GetPaused(domainID uint8) (bool, error) {
+	key := bytes.Buffer{}
+	keyS := fmt.Sprintf("chain:%d:spending:paused", domainID)
+	key.WriteString(keyS)
+
+	v, err := ss.db.GetByKey(key.Bytes())
+	if err != nil {
+		if errors.Is(err, leveldb.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return len(v) > 0 && v[0] == 1, nil
+}
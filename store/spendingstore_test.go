@@ -0,0 +1,128 @@
+package store_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ChainSafe/chainbridge-core/store"
+	mock_store "github.com/ChainSafe/chainbridge-core/store/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type SpendingStoreTestSuite struct {
+	suite.Suite
+	spendingStore        *store.SpendingStore
+	keyValueReaderWriter *mock_store.MockKeyValueReaderWriter
+}
+
+func TestRunSpendingStoreTestSuite(t *testing.T) {
+	suite.Run(t, new(SpendingStoreTestSuite))
+}
+
+func (s *SpendingStoreTestSuite) SetupSuite()    {}
+func (s *SpendingStoreTestSuite) TearDownSuite() {}
+func (s *SpendingStoreTestSuite) SetupTest() {
+	gomockController := gomock.NewController(s.T())
+	s.keyValueReaderWriter = mock_store.NewMockKeyValueReaderWriter(gomockController)
+	s.spendingStore = store.NewSpendingStore(s.keyValueReaderWriter)
+}
+func (s *SpendingStoreTestSuite) TearDownTest() {}
+
+func (s *SpendingStoreTestSuite) TestStoreSpending_SuccessfulStore() {
+	key := "chain:1:spending:hour:100"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte("chain:1:spending:hour:windows")).Return(nil, leveldb.ErrNotFound)
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte("chain:1:spending:hour:windows"), []byte{0, 0, 0, 0, 0, 0, 0, 100}).Return(nil)
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), []byte{5}).Return(nil)
+
+	err := s.spendingStore.StoreSpending(1, "hour", 100, big.NewInt(5))
+
+	s.Nil(err)
+}
+
+func (s *SpendingStoreTestSuite) TestGetSpending_FailedFetch() {
+	key := "chain:1:spending:day:4"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, errors.New("error"))
+
+	_, err := s.spendingStore.GetSpending(1, "day", 4)
+
+	s.NotNil(err)
+}
+
+func (s *SpendingStoreTestSuite) TestGetSpending_NotFound() {
+	key := "chain:1:spending:day:4"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, leveldb.ErrNotFound)
+
+	spent, err := s.spendingStore.GetSpending(1, "day", 4)
+
+	s.Nil(err)
+	s.Equal(big.NewInt(0), spent)
+}
+
+func (s *SpendingStoreTestSuite) TestGetSpending_SuccessfulFetch() {
+	key := "chain:1:spending:day:4"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return([]byte{5}, nil)
+
+	spent, err := s.spendingStore.GetSpending(1, "day", 4)
+
+	s.Nil(err)
+	s.Equal(big.NewInt(5), spent)
+}
+
+func (s *SpendingStoreTestSuite) TestStorePaused_SuccessfulStore() {
+	key := "chain:1:spending:paused"
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), []byte{1}).Return(nil)
+
+	err := s.spendingStore.StorePaused(1, true)
+
+	s.Nil(err)
+}
+
+func (s *SpendingStoreTestSuite) TestGetPaused_NotFound() {
+	key := "chain:1:spending:paused"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return(nil, leveldb.ErrNotFound)
+
+	paused, err := s.spendingStore.GetPaused(1)
+
+	s.Nil(err)
+	s.False(paused)
+}
+
+func (s *SpendingStoreTestSuite) TestGetPaused_SuccessfulFetch() {
+	key := "chain:1:spending:paused"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte(key)).Return([]byte{1}, nil)
+
+	paused, err := s.spendingStore.GetPaused(1)
+
+	s.Nil(err)
+	s.True(paused)
+}
+
+func (s *SpendingStoreTestSuite) TestStoreSpending_KnownWindowNotIndexedAgain() {
+	key := "chain:1:spending:hour:100"
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte("chain:1:spending:hour:windows")).Return([]byte{0, 0, 0, 0, 0, 0, 0, 100}, nil)
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte(key), []byte{5}).Return(nil)
+
+	err := s.spendingStore.StoreSpending(1, "hour", 100, big.NewInt(5))
+
+	s.Nil(err)
+}
+
+func (s *SpendingStoreTestSuite) TestPruneSpending_PastWindowsDeleted() {
+	s.keyValueReaderWriter.EXPECT().GetByKey([]byte("chain:1:spending:hour:windows")).Return([]byte{
+		0, 0, 0, 0, 0, 0, 0, 75,
+		0, 0, 0, 0, 0, 0, 0, 76,
+		0, 0, 0, 0, 0, 0, 0, 100,
+	}, nil)
+	s.keyValueReaderWriter.EXPECT().DeleteByKey([]byte("chain:1:spending:hour:75")).Return(nil)
+	s.keyValueReaderWriter.EXPECT().SetByKey([]byte("chain:1:spending:hour:windows"), []byte{
+		0, 0, 0, 0, 0, 0, 0, 76,
+		0, 0, 0, 0, 0, 0, 0, 100,
+	}).Return(nil)
+
+	err := s.spendingStore.PruneSpending(1, "hour", 76)
+
+	s.Nil(err)
+}
@@ -17,4 +17,5 @@ type KeyValueReader interface {
 
 type KeyValueWriter interface {
 	SetByKey(key []byte, value []byte) error
+	DeleteByKey(key []byte) error
 }